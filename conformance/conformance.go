@@ -0,0 +1,168 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-mock/withinfo"
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A conformance check, run as a subtest.
+type check struct {
+	name string
+	run  func(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string)
+}
+
+// The fields of the documents returned by GetRecord() and the "...WithInfo" methods that are checked.
+type recordJson struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The maximum number of FetchNext() calls of an export before it is reported as not terminating.
+const maxFetches = 100000
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// The checks run by Run(), in order.
+var checks = []check{
+	{"RecordLifecycle", checkRecordLifecycle},
+	{"UnknownRecord", checkUnknownRecord},
+	{"WithInfo", checkWithInfo},
+	{"ExportLifecycle", checkExportLifecycle},
+	{"CountRedoRecords", checkCountRedoRecords},
+	{"Stats", checkStats},
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the JSON data of a record of the conformance checks.
+func recordJsonData(dataSourceCode string, recordID string, name string) string {
+	return fmt.Sprintf(`{"DATA_SOURCE":%q,"RECORD_ID":%q,"NAME_FULL":%q}`, dataSourceCode, recordID, name)
+}
+
+// A record that is added is returned by GetRecord(), and is unknown once deleted.
+func checkRecordLifecycle(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	recordID := "CONFORMANCE-1001"
+	err := g2engine.AddRecord(ctx, dataSourceCode, recordID, recordJsonData(dataSourceCode, recordID, "Robert Smith"), "")
+	if !assert.NoError(test, err, "AddRecord") {
+		return
+	}
+	document, err := g2engine.GetRecord(ctx, dataSourceCode, recordID)
+	if assert.NoError(test, err, "GetRecord of an added record") {
+		record := recordJson{}
+		assert.NoError(test, json.Unmarshal([]byte(document), &record), "GetRecord returns a JSON document")
+		assert.Equal(test, recordJson{DataSource: dataSourceCode, RecordID: recordID}, record, "GetRecord identifies the record")
+	}
+	err = g2engine.ReplaceRecord(ctx, dataSourceCode, recordID, recordJsonData(dataSourceCode, recordID, "Bob Smith"), "")
+	assert.NoError(test, err, "ReplaceRecord of an added record")
+	err = g2engine.DeleteRecord(ctx, dataSourceCode, recordID, "")
+	assert.NoError(test, err, "DeleteRecord")
+	_, err = g2engine.GetRecord(ctx, dataSourceCode, recordID)
+	assert.Error(test, err, "GetRecord of a deleted record")
+}
+
+// Reading a record that was never added is an error.
+func checkUnknownRecord(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	_, err := g2engine.GetRecord(ctx, dataSourceCode, "CONFORMANCE-UNKNOWN")
+	assert.Error(test, err, "GetRecord of an unknown record")
+	_, err = g2engine.GetEntityByRecordID(ctx, dataSourceCode, "CONFORMANCE-UNKNOWN")
+	assert.Error(test, err, "GetEntityByRecordID of an unknown record")
+}
+
+// The "...WithInfo" methods identify the record and list the affected entities.
+func checkWithInfo(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	recordID := "CONFORMANCE-1002"
+	document, err := g2engine.AddRecordWithInfo(ctx, dataSourceCode, recordID, recordJsonData(dataSourceCode, recordID, "Mary Jones"), "", 0)
+	if !assert.NoError(test, err, "AddRecordWithInfo") {
+		return
+	}
+	record := recordJson{}
+	assert.NoError(test, json.Unmarshal([]byte(document), &record), "AddRecordWithInfo returns a JSON document")
+	assert.Equal(test, recordJson{DataSource: dataSourceCode, RecordID: recordID}, record, "AddRecordWithInfo identifies the record")
+	entityIDs, err := withinfo.AffectedEntityIDs(document)
+	assert.NoError(test, err, "AddRecordWithInfo lists AFFECTED_ENTITIES")
+	assert.NotEmpty(test, entityIDs, "AddRecordWithInfo affects an entity")
+	_, err = g2engine.DeleteRecordWithInfo(ctx, dataSourceCode, recordID, "", 0)
+	assert.NoError(test, err, "DeleteRecordWithInfo")
+}
+
+// An export handle returns pages until an empty string, can be closed, and cannot be used once closed.
+func checkExportLifecycle(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	if !assert.NoError(test, err, "ExportJSONEntityReport") {
+		return
+	}
+	fetches := 0
+	for ; fetches < maxFetches; fetches++ {
+		page, err := g2engine.FetchNext(ctx, responseHandle)
+		if !assert.NoError(test, err, "FetchNext of an open export") {
+			break
+		}
+		if len(page) == 0 {
+			break
+		}
+	}
+	assert.Less(test, fetches, maxFetches, "FetchNext ends the export with an empty string")
+	err = g2engine.CloseExport(ctx, responseHandle)
+	assert.NoError(test, err, "CloseExport")
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	assert.Error(test, err, "FetchNext of a closed export")
+}
+
+// The number of redo records is never negative.
+func checkCountRedoRecords(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	count, err := g2engine.CountRedoRecords(ctx)
+	if assert.NoError(test, err, "CountRedoRecords") {
+		assert.GreaterOrEqual(test, count, int64(0), "CountRedoRecords")
+	}
+}
+
+// Stats() returns a JSON object.
+func checkStats(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	document, err := g2engine.Stats(ctx)
+	if assert.NoError(test, err, "Stats") {
+		stats := map[string]interface{}{}
+		assert.NoError(test, json.Unmarshal([]byte(document), &stats), "Stats returns a JSON object")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Run function runs the conformance checks against a G2engine, each as a subtest of test,
+so that failures name the behavior that differs, e.g. "TestConformance/ExportLifecycle".
+
+Input
+  - test: The test.
+  - ctx: A context to control lifecycle.
+  - g2engine: An initialized G2engine, e.g. a mock with Stateful set, or a g2-sdk-go-base G2engine.
+  - dataSourceCode: A data source registered in the configuration of the G2engine.
+*/
+func Run(test *testing.T, ctx context.Context, g2engine g2api.G2engine, dataSourceCode string) {
+	test.Helper()
+	for _, check := range checks {
+		check := check
+		test.Run(check.name, func(test *testing.T) {
+			check.run(test, ctx, g2engine, dataSourceCode)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-mock/g2engine"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRun(test *testing.T) {
+	ctx := context.TODO()
+	mock := &g2engine.G2engine{
+		Stateful:    true,
+		ExportPages: []string{`{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`},
+	}
+	err := mock.Init(ctx, "Test module name", "{}", 0)
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer mock.Destroy(ctx)
+	Run(test, ctx, mock, "TEST")
+}
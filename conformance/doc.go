@@ -0,0 +1,13 @@
+/*
+The conformance package checks the behavior that code written against the mock relies on,
+such as record lifecycle, errors for unknown records and the export handle lifecycle,
+against any g2api.G2engine: the mock, or the g2-sdk-go-base and g2-sdk-go-grpc implementations, e.g.
+
+	func TestConformance(test *testing.T) {
+		conformance.Run(test, context.TODO(), g2engine, "TEST")
+	}
+
+The G2engine must be initialized and the data source must be registered in its configuration.
+The checks add, replace and delete records with IDs starting with "CONFORMANCE-".
+*/
+package conformance
@@ -0,0 +1,9 @@
+/*
+The fixtures package constructs the JOHNSON/OCEANGUY/Smith dataset used throughout the
+g2engine documentation examples.
+The records can seed a mock, and the entity, why and how documents can be used as "...Result" values.
+
+The international dataset has names and addresses in Arabic, Hebrew, Chinese and Cyrillic scripts
+and in Latin script with diacritics, to test Unicode handling in records, entities and search responses.
+*/
+package fixtures
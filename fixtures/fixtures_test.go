@@ -0,0 +1,138 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRecords(test *testing.T) {
+	records := Records()
+	assert.Len(test, records, 4)
+	for _, record := range records {
+		jsonData := map[string]interface{}{}
+		assert.NoError(test, json.Unmarshal([]byte(record.JsonData), &jsonData), record.RecordID)
+		assert.Equal(test, DataSourceCode, jsonData["DATA_SOURCE"])
+		assert.NotNil(test, Entity(record.EntityID), record.RecordID)
+	}
+	records[0].RecordID = "changed"
+	assert.Equal(test, "111", Records()[0].RecordID)
+}
+
+func TestEntity(test *testing.T) {
+	document := struct {
+		ResolvedEntity  resultbuilder.ResolvedEntity  `json:"RESOLVED_ENTITY"`
+		RelatedEntities []resultbuilder.RelatedEntity `json:"RELATED_ENTITIES"`
+	}{}
+	err := json.Unmarshal([]byte(Entity(JohnsonEntityID).JSON()), &document)
+	assert.NoError(test, err)
+	assert.Equal(test, "JOHNSON", document.ResolvedEntity.EntityName)
+	assert.Equal(test, []resultbuilder.RecordSummary{
+		{DataSource: "TEST", RecordCount: 2, FirstSeenDt: "2022-12-06 14:40:34.285", LastSeenDt: "2022-12-06 14:40:34.420"},
+	}, document.ResolvedEntity.RecordSummary)
+	assert.Equal(test, "2022-12-06 14:40:34.420", document.ResolvedEntity.LastSeenDt)
+	assert.Len(test, document.RelatedEntities, 2)
+	assert.Equal(test, "OCEANGUY", document.RelatedEntities[0].EntityName)
+	assert.Equal(test, "+PHONE+ACCT_NUM-SSN", document.RelatedEntities[0].MatchKey)
+	assert.Equal(test, "POSSIBLY_RELATED", document.RelatedEntities[0].MatchLevelCode)
+	assert.Equal(test, "2022-12-06 14:40:34.424", document.RelatedEntities[1].LastSeenDt)
+	assert.Nil(test, Entity(4))
+}
+
+func TestEntityByRecordID(test *testing.T) {
+	assert.Equal(test, Entity(JohnsonEntityID).JSON(), EntityByRecordID("TEST", "FCCE9793DAAD23159DBCCEB97FF2745B92CE7919").JSON())
+	assert.Nil(test, EntityByRecordID("TEST", "444"))
+}
+
+func TestWhyEntities(test *testing.T) {
+	document := struct {
+		WhyResults []resultbuilder.WhyResult `json:"WHY_RESULTS"`
+	}{}
+	err := json.Unmarshal([]byte(WhyEntities(OceanguyEntityID, SmithEntityID).JSON()), &document)
+	assert.NoError(test, err)
+	assert.Len(test, document.WhyResults, 1)
+	matchInfo := document.WhyResults[0].MatchInfo
+	assert.Equal(test, "+ADDRESS+PHONE+ACCT_NUM-DOB-SSN", matchInfo.WhyKey)
+	assert.Equal(test, "POSSIBLY_RELATED", matchInfo.MatchLevelCode)
+	assert.Equal(test, "SAME", matchInfo.FeatureScores["ADDRESS"][0].ScoreBucket)
+	assert.Equal(test, "NO_CHANCE", matchInfo.FeatureScores["SSN"][0].ScoreBucket)
+	assert.Contains(test, matchInfo.CandidateKeys, "PHONE")
+	assert.NotContains(test, matchInfo.CandidateKeys, "DOB")
+	assert.Nil(test, WhyEntities(JohnsonEntityID, JohnsonEntityID))
+}
+
+func TestHowEntity(test *testing.T) {
+	actual, err := HowEntity(JohnsonEntityID)
+	assert.NoError(test, err)
+	assert.Contains(test, actual, `"RESULT_VIRTUAL_ENTITY_ID":"V1-S1"`)
+	assert.Contains(test, actual, `"MATCH_KEY":"+EXACTLY_SAME"`)
+	actual, err = HowEntity(SmithEntityID)
+	assert.NoError(test, err)
+	assert.Contains(test, actual, `"RESOLUTION_STEPS":[]`)
+	_, err = HowEntity(4)
+	assert.Error(test, err)
+}
+
+func TestInternationalRecords(test *testing.T) {
+	records := InternationalRecords()
+	assert.Len(test, records, 8)
+	for _, record := range records {
+		jsonData := map[string]interface{}{}
+		assert.NoError(test, json.Unmarshal([]byte(record.JsonData), &jsonData), record.RecordID)
+		assert.Equal(test, InternationalDataSourceCode, jsonData["DATA_SOURCE"])
+		assert.Equal(test, record.RecordID, jsonData["RECORD_ID"])
+		assert.NotNil(test, InternationalEntity(record.EntityID), record.RecordID)
+	}
+}
+
+func TestInternationalEntity(test *testing.T) {
+	document := struct {
+		ResolvedEntity resultbuilder.ResolvedEntity `json:"RESOLVED_ENTITY"`
+	}{}
+	err := json.Unmarshal([]byte(InternationalEntity(CyrillicEntityID).JSON()), &document)
+	assert.NoError(test, err)
+	assert.Equal(test, "Мария Иванова", document.ResolvedEntity.EntityName)
+	assert.Len(test, document.ResolvedEntity.Records, 2)
+	assert.Nil(test, InternationalEntity(JohnsonEntityID))
+}
+
+func TestInternationalSearch(test *testing.T) {
+	document := struct {
+		ResolvedEntities []struct {
+			Entity struct {
+				ResolvedEntity resultbuilder.ResolvedEntity `json:"RESOLVED_ENTITY"`
+			} `json:"ENTITY"`
+		} `json:"RESOLVED_ENTITIES"`
+	}{}
+	err := json.Unmarshal([]byte(InternationalSearch("maria ivanova").JSON()), &document)
+	assert.NoError(test, err)
+	assert.Len(test, document.ResolvedEntities, 1)
+	assert.Equal(test, CyrillicEntityID, document.ResolvedEntities[0].Entity.ResolvedEntity.EntityID)
+	err = json.Unmarshal([]byte(InternationalSearch("דוד כהן").JSON()), &document)
+	assert.NoError(test, err)
+	assert.Equal(test, "דוד כהן", document.ResolvedEntities[0].Entity.ResolvedEntity.EntityName)
+	assert.Contains(test, InternationalSearch("Zoë Müller-Ødegård").JSON(), "Straße des 17. Juni 135, Berlin")
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleRecords() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/fixtures/fixtures_test.go
+	for _, record := range Records() {
+		fmt.Println(record.DataSourceCode, record.RecordID, record.EntityID)
+	}
+	// Output:
+	// TEST 111 1
+	// TEST 222 2
+	// TEST FCCE9793DAAD23159DBCCEB97FF2745B92CE7919 1
+	// TEST 333 3
+}
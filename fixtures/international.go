@@ -0,0 +1,204 @@
+package fixtures
+
+import (
+	"strings"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Data source of every record in the international dataset.
+const InternationalDataSourceCode = "INTERNATIONAL"
+
+// Entity identifiers of the international dataset.
+const (
+	ArabicEntityID     int64 = 101 // Arabic script, right-to-left.
+	HebrewEntityID     int64 = 102 // Hebrew script, right-to-left.
+	ChineseEntityID    int64 = 103 // Han characters.
+	CyrillicEntityID   int64 = 104 // Cyrillic script, resolved with a Latin transliteration.
+	SpanishEntityID    int64 = 105 // Latin script with diacritics.
+	VietnameseEntityID int64 = 106 // Latin script with stacked diacritics.
+	GermanEntityID     int64 = 107 // Latin script with umlauts, a ligature and a slashed o.
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A person of the international dataset.
+type internationalPerson struct {
+	entityID    int64
+	name        string
+	address     string
+	dateOfBirth string
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var internationalPeople = []internationalPerson{
+	{ArabicEntityID, "محمد عبد الله", "شارع الملك فهد، الرياض", "1980-02-14"},
+	{HebrewEntityID, "דוד כהן", "רחוב הרצל 10, תל אביב", "1975-07-01"},
+	{ChineseEntityID, "王小明", "北京市朝阳区建国路88号", "1992-11-30"},
+	{CyrillicEntityID, "Мария Иванова", "ул. Тверская 7, Москва", "1988-03-08"},
+	{SpanishEntityID, "José Ñúñez Peña", "Calle Mayor 5, Málaga", "1969-12-24"},
+	{VietnameseEntityID, "Nguyễn Thị Ánh", "Phường Bến Nghé, Quận 1, Hồ Chí Minh", "1995-05-19"},
+	{GermanEntityID, "Zoë Müller-Ødegård", "Straße des 17. Juni 135, Berlin", "1983-09-03"},
+}
+
+var internationalRecords = []SeedRecord{
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-101",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-101", "NAME_FULL": "محمد عبد الله", "ADDR_FULL": "شارع الملك فهد، الرياض", "DATE_OF_BIRTH": "1980-02-14"}`,
+		EntityID:       ArabicEntityID,
+		InternalID:     101,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-102",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-102", "NAME_FULL": "דוד כהן", "ADDR_FULL": "רחוב הרצל 10, תל אביב", "DATE_OF_BIRTH": "1975-07-01"}`,
+		EntityID:       HebrewEntityID,
+		InternalID:     102,
+		LoadOffset:     10 * time.Millisecond,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-103",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-103", "NAME_FULL": "王小明", "ADDR_FULL": "北京市朝阳区建国路88号", "DATE_OF_BIRTH": "1992-11-30"}`,
+		EntityID:       ChineseEntityID,
+		InternalID:     103,
+		LoadOffset:     20 * time.Millisecond,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-104",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-104", "NAME_FULL": "Мария Иванова", "ADDR_FULL": "ул. Тверская 7, Москва", "DATE_OF_BIRTH": "1988-03-08"}`,
+		EntityID:       CyrillicEntityID,
+		InternalID:     104,
+		LoadOffset:     30 * time.Millisecond,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-104-LATN",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-104-LATN", "NAME_FULL": "Maria Ivanova", "DATE_OF_BIRTH": "1988-03-08"}`,
+		EntityID:       CyrillicEntityID,
+		InternalID:     108,
+		MatchKey:       "+NAME+DOB",
+		LoadOffset:     40 * time.Millisecond,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-105",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-105", "NAME_FULL": "José Ñúñez Peña", "ADDR_FULL": "Calle Mayor 5, Málaga", "DATE_OF_BIRTH": "1969-12-24"}`,
+		EntityID:       SpanishEntityID,
+		InternalID:     105,
+		LoadOffset:     50 * time.Millisecond,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-106",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-106", "NAME_FULL": "Nguyễn Thị Ánh", "ADDR_FULL": "Phường Bến Nghé, Quận 1, Hồ Chí Minh", "DATE_OF_BIRTH": "1995-05-19"}`,
+		EntityID:       VietnameseEntityID,
+		InternalID:     106,
+		LoadOffset:     60 * time.Millisecond,
+	},
+	{
+		DataSourceCode: InternationalDataSourceCode,
+		RecordID:       "INT-107",
+		JsonData:       `{"DATA_SOURCE": "INTERNATIONAL", "RECORD_ID": "INT-107", "NAME_FULL": "Zoë Müller-Ødegård", "ADDR_FULL": "Straße des 17. Juni 135, Berlin", "DATE_OF_BIRTH": "1983-09-03"}`,
+		EntityID:       GermanEntityID,
+		InternalID:     107,
+		LoadOffset:     70 * time.Millisecond,
+	},
+}
+
+// Additional NAME values of entities, e.g. transliterations.
+var internationalAliases = map[int64][]string{
+	CyrillicEntityID: {"Maria Ivanova"},
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Find a person of the international dataset.
+func findInternationalPerson(entityID int64) (internationalPerson, bool) {
+	for _, candidate := range internationalPeople {
+		if candidate.entityID == entityID {
+			return candidate, true
+		}
+	}
+	return internationalPerson{}, false
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// InternationalRecords returns the records of the international dataset, in load order.
+// Their names and addresses use non-Latin and right-to-left scripts and Latin diacritics.
+func InternationalRecords() []SeedRecord {
+	return append([]SeedRecord{}, internationalRecords...)
+}
+
+// InternationalEntity returns the document returned by GetEntityByEntityID() for an entity of the international dataset.
+// The result is nil for an unknown entity.
+func InternationalEntity(entityID int64) *resultbuilder.EntityDoc {
+	person, ok := findInternationalPerson(entityID)
+	if !ok {
+		return nil
+	}
+	result := resultbuilder.NewEntityDoc().EntityID(person.entityID).Name(person.name)
+	result.Feature("NAME", person.name)
+	for _, alias := range internationalAliases[entityID] {
+		result.Feature("NAME", alias)
+	}
+	result.Feature("ADDRESS", person.address)
+	result.Feature("DOB", person.dateOfBirth)
+	lastSeen := LoadTime
+	for _, record := range internationalRecords {
+		if record.EntityID != entityID {
+			continue
+		}
+		seen := LoadTime.Add(record.LoadOffset)
+		if seen.After(lastSeen) {
+			lastSeen = seen
+		}
+		result.AddRecord(resultbuilder.Record{
+			DataSource: record.DataSourceCode,
+			RecordID:   record.RecordID,
+			EntityType: InternationalDataSourceCode,
+			InternalID: record.InternalID,
+			EntityDesc: person.name,
+			MatchKey:   record.MatchKey,
+			LastSeenDt: resultbuilder.FormatTimestamp(seen),
+		})
+	}
+	return result.LastSeen(lastSeen)
+}
+
+// InternationalSearch returns the document returned by SearchByAttributes() for a NAME_FULL search
+// of the international dataset. Entities with a NAME equal to the name, ignoring case, are resolved.
+func InternationalSearch(name string) *resultbuilder.SearchDoc {
+	result := resultbuilder.NewSearchDoc()
+	for _, person := range internationalPeople {
+		names := append([]string{person.name}, internationalAliases[person.entityID]...)
+		for _, candidateName := range names {
+			if !strings.EqualFold(candidateName, name) {
+				continue
+			}
+			result.AddCandidate(resultbuilder.NewSearchCandidate(InternationalEntity(person.entityID)).
+				MatchLevel(1).
+				MatchKey("+NAME", "SF1").
+				FeatureScore("NAME", name, candidateName, 100))
+			break
+		}
+	}
+	return result
+}
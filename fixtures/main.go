@@ -0,0 +1,24 @@
+package fixtures
+
+import "time"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Data source of every record in the dataset.
+const DataSourceCode = "TEST"
+
+// Entity identifiers of the dataset.
+const (
+	JohnsonEntityID  int64 = 1
+	OceanguyEntityID int64 = 2
+	SmithEntityID    int64 = 3
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Time the first record of the dataset was loaded.
+var LoadTime = time.Date(2022, 12, 6, 14, 40, 34, 285000000, time.UTC)
@@ -0,0 +1,316 @@
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SeedRecord is a record of the dataset, in the form passed to G2engine.AddRecord().
+type SeedRecord struct {
+	DataSourceCode string
+	RecordID       string
+	JsonData       string
+	EntityID       int64         // Entity the record resolves to.
+	InternalID     int64         // INTERNAL_ID of the record within its entity.
+	MatchKey       string        // MATCH_KEY that joined the record to its entity.
+	LoadOffset     time.Duration // Time after LoadTime at which the record was loaded.
+}
+
+// Relationship describes a relationship between two entities of the dataset.
+type Relationship struct {
+	EntityID   int64
+	EntityID2  int64
+	MatchLevel int
+	MatchKey   string
+	ErruleCode string
+}
+
+// A resolved feature value.
+type feature struct {
+	featureType string
+	featID      int64
+	value       string
+}
+
+// An entity of the dataset.
+type entity struct {
+	entityID  int64
+	name      string
+	entityKey string
+	features  []feature
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var seedRecords = []SeedRecord{
+	{
+		DataSourceCode: DataSourceCode,
+		RecordID:       "111",
+		JsonData:       `{"DATA_SOURCE": "TEST", "RECORD_ID": "111", "SOCIAL_HANDLE": "flavorh", "DATE_OF_BIRTH": "4/8/1983", "ADDR_STATE": "LA", "ADDR_POSTAL_CODE": "71232", "SSN_NUMBER": "053-39-3251", "GENDER": "F", "srccode": "MDMPER", "CC_ACCOUNT_NUMBER": "5534202208773608", "ADDR_CITY": "Delhi", "DRIVERS_LICENSE_STATE": "DE", "PHONE_NUMBER": "225-671-0796", "NAME_LAST": "JOHNSON", "entityid": "284430058", "ADDR_LINE1": "772 Armstrong RD"}`,
+		EntityID:       JohnsonEntityID,
+		InternalID:     1,
+	},
+	{
+		DataSourceCode: DataSourceCode,
+		RecordID:       "222",
+		JsonData:       `{"DATA_SOURCE": "TEST", "RECORD_ID": "222", "DATE_OF_BIRTH": "6/9/1983", "ADDR_STATE": "WI", "ADDR_POSTAL_CODE": "53543", "SSN_NUMBER": "153-33-5185", "GENDER": "F", "srccode": "MDMPER", "CC_ACCOUNT_NUMBER": "5534202208773608", "ADDR_CITY": "Delhi", "DRIVERS_LICENSE_STATE": "DE", "PHONE_NUMBER": "225-671-0796", "NAME_LAST": "OCEANGUY", "entityid": "284430058", "ADDR_LINE1": "772 Armstrong RD"}`,
+		EntityID:       OceanguyEntityID,
+		InternalID:     2,
+		LoadOffset:     74 * time.Millisecond,
+	},
+	{
+		DataSourceCode: DataSourceCode,
+		RecordID:       "FCCE9793DAAD23159DBCCEB97FF2745B92CE7919",
+		JsonData:       `{"DATA_SOURCE": "TEST", "SOCIAL_HANDLE": "flavorh", "DATE_OF_BIRTH": "4/8/1983", "ADDR_STATE": "LA", "ADDR_POSTAL_CODE": "71232", "SSN_NUMBER": "053-39-3251", "GENDER": "F", "srccode": "MDMPER", "CC_ACCOUNT_NUMBER": "5534202208773608", "ADDR_CITY": "Delhi", "DRIVERS_LICENSE_STATE": "DE", "PHONE_NUMBER": "225-671-0796", "NAME_LAST": "JOHNSON", "entityid": "284430058", "ADDR_LINE1": "772 Armstrong RD"}`,
+		EntityID:       JohnsonEntityID,
+		InternalID:     1,
+		MatchKey:       "+EXACTLY_SAME",
+		LoadOffset:     135 * time.Millisecond,
+	},
+	{
+		DataSourceCode: DataSourceCode,
+		RecordID:       "333",
+		JsonData:       `{"DATA_SOURCE": "TEST", "RECORD_ID": "333", "DATE_OF_BIRTH": "3/13/1990", "ADDR_STATE": "WI", "ADDR_POSTAL_CODE": "53543", "SSN_NUMBER": "753-65-8237", "GENDER": "M", "srccode": "MDMPER", "CC_ACCOUNT_NUMBER": "5534202208773608", "ADDR_CITY": "Delhi", "DRIVERS_LICENSE_STATE": "DE", "PHONE_NUMBER": "225-671-0796", "NAME_LAST": "Smith", "entityid": "284430058", "ADDR_LINE1": "772 Armstrong RD"}`,
+		EntityID:       SmithEntityID,
+		InternalID:     3,
+		LoadOffset:     139 * time.Millisecond,
+	},
+}
+
+var entities = []entity{
+	{
+		entityID:  JohnsonEntityID,
+		name:      "JOHNSON",
+		entityKey: "C6063D4396612FBA7324DB0739273BA1FE815C43",
+		features: []feature{
+			{"ACCT_NUM", 8, "5534202208773608"},
+			{"ADDRESS", 4, "772 Armstrong RD Delhi LA 71232"},
+			{"DOB", 2, "4/8/1983"},
+			{"PHONE", 5, "225-671-0796"},
+			{"SSN", 6, "053-39-3251"},
+		},
+	},
+	{
+		entityID:  OceanguyEntityID,
+		name:      "OCEANGUY",
+		entityKey: "740BA22D15CA88462A930AF8A7C904FF5E48226C",
+		features: []feature{
+			{"ACCT_NUM", 8, "5534202208773608"},
+			{"ADDRESS", 26, "772 Armstrong RD Delhi WI 53543"},
+			{"DOB", 25, "6/9/1983"},
+			{"PHONE", 5, "225-671-0796"},
+			{"SSN", 27, "153-33-5185"},
+		},
+	},
+	{
+		entityID:  SmithEntityID,
+		name:      "Smith",
+		entityKey: "3A9B7E0C41D5F6E28B1C94A7D03E5F8621B4C7D9",
+		features: []feature{
+			{"ACCT_NUM", 8, "5534202208773608"},
+			{"ADDRESS", 26, "772 Armstrong RD Delhi WI 53543"},
+			{"DOB", 30, "3/13/1990"},
+			{"PHONE", 5, "225-671-0796"},
+			{"SSN", 31, "753-65-8237"},
+		},
+	},
+}
+
+var relationships = []Relationship{
+	{EntityID: JohnsonEntityID, EntityID2: OceanguyEntityID, MatchLevel: 3, MatchKey: "+PHONE+ACCT_NUM-SSN", ErruleCode: "SF1"},
+	{EntityID: JohnsonEntityID, EntityID2: SmithEntityID, MatchLevel: 3, MatchKey: "+PHONE+ACCT_NUM-DOB-SSN", ErruleCode: "SF1"},
+	{EntityID: OceanguyEntityID, EntityID2: SmithEntityID, MatchLevel: 3, MatchKey: "+ADDRESS+PHONE+ACCT_NUM-DOB-SSN", ErruleCode: "SF1"},
+}
+
+// FULL_SCORE of two different values of a feature type.
+var mismatchScores = map[string]int{
+	"ACCT_NUM": 0,
+	"ADDRESS":  81,
+	"DOB":      79,
+	"PHONE":    0,
+	"SSN":      30,
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Find an entity of the dataset.
+func findEntity(entityID int64) (entity, bool) {
+	for _, candidate := range entities {
+		if candidate.entityID == entityID {
+			return candidate, true
+		}
+	}
+	return entity{}, false
+}
+
+// Build an entity document without RELATED_ENTITIES.
+func baseEntity(entityID int64) *resultbuilder.EntityDoc {
+	found, ok := findEntity(entityID)
+	if !ok {
+		return nil
+	}
+	result := resultbuilder.NewEntityDoc().EntityID(found.entityID)
+	result.Name(found.name)
+	for _, value := range found.features {
+		result.Feature(value.featureType, value.value)
+	}
+	lastSeen := LoadTime
+	for _, record := range seedRecords {
+		if record.EntityID != entityID {
+			continue
+		}
+		seen := LoadTime.Add(record.LoadOffset)
+		if seen.After(lastSeen) {
+			lastSeen = seen
+		}
+		result.AddRecord(resultbuilder.Record{
+			DataSource: record.DataSourceCode,
+			RecordID:   record.RecordID,
+			EntityType: DataSourceCode,
+			InternalID: record.InternalID,
+			EntityKey:  found.entityKey,
+			EntityDesc: found.name,
+			MatchKey:   record.MatchKey,
+			LastSeenDt: resultbuilder.FormatTimestamp(seen),
+		})
+	}
+	return result.LastSeen(lastSeen)
+}
+
+// Return the value of a feature type, if the entity has one.
+func featureValue(values []feature, featureType string) (feature, bool) {
+	for _, value := range values {
+		if value.featureType == featureType {
+			return value, true
+		}
+	}
+	return feature{}, false
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Records returns the records of the dataset, in load order.
+func Records() []SeedRecord {
+	return append([]SeedRecord{}, seedRecords...)
+}
+
+// Relationships returns the relationships between the entities of the dataset.
+func Relationships() []Relationship {
+	return append([]Relationship{}, relationships...)
+}
+
+// Entity returns the document returned by GetEntityByEntityID() for an entity of the dataset.
+// The result is nil for an unknown entity.
+func Entity(entityID int64) *resultbuilder.EntityDoc {
+	result := baseEntity(entityID)
+	if result == nil {
+		return nil
+	}
+	for _, relationship := range relationships {
+		relatedID := relationship.EntityID2
+		if relationship.EntityID2 == entityID {
+			relatedID = relationship.EntityID
+		} else if relationship.EntityID != entityID {
+			continue
+		}
+		related := baseEntity(relatedID).ResolvedEntity()
+		result.AddRelated(resultbuilder.RelatedEntity{
+			EntityID:      relatedID,
+			MatchLevel:    relationship.MatchLevel,
+			MatchKey:      relationship.MatchKey,
+			ErruleCode:    relationship.ErruleCode,
+			EntityName:    related.EntityName,
+			RecordSummary: related.RecordSummary,
+			LastSeenDt:    related.LastSeenDt,
+		})
+	}
+	return result
+}
+
+// EntityByRecordID returns the document returned by GetEntityByRecordID() for a record of the dataset.
+// The result is nil for an unknown record.
+func EntityByRecordID(dataSourceCode string, recordID string) *resultbuilder.EntityDoc {
+	for _, record := range seedRecords {
+		if record.DataSourceCode == dataSourceCode && record.RecordID == recordID {
+			return Entity(record.EntityID)
+		}
+	}
+	return nil
+}
+
+// WhyEntities returns the document returned by WhyEntities() for two entities of the dataset.
+// The result is nil unless the entities are related.
+func WhyEntities(entityID1 int64, entityID2 int64) *resultbuilder.WhyDoc {
+	var found *Relationship
+	for i, relationship := range relationships {
+		if (relationship.EntityID == entityID1 && relationship.EntityID2 == entityID2) ||
+			(relationship.EntityID == entityID2 && relationship.EntityID2 == entityID1) {
+			found = &relationships[i]
+		}
+	}
+	if found == nil {
+		return nil
+	}
+	inbound, _ := findEntity(entityID1)
+	candidate, _ := findEntity(entityID2)
+	whyResult := resultbuilder.NewWhyResult(entityID1, entityID2).
+		WhyKey(found.MatchKey, found.ErruleCode).
+		MatchLevel(found.MatchLevel)
+	for _, inboundValue := range inbound.features {
+		candidateValue, ok := featureValue(candidate.features, inboundValue.featureType)
+		if !ok {
+			continue
+		}
+		fullScore := 100
+		if inboundValue.value != candidateValue.value {
+			fullScore = mismatchScores[inboundValue.featureType]
+		} else {
+			whyResult.CandidateKey(inboundValue.featureType, inboundValue.featID, inboundValue.value)
+		}
+		whyResult.FeatureScore(inboundValue.featureType, inboundValue.value, candidateValue.value, fullScore)
+	}
+	return resultbuilder.NewWhyDoc().
+		AddResult(whyResult).
+		AddEntity(Entity(entityID1)).
+		AddEntity(Entity(entityID2))
+}
+
+// HowEntity returns the document returned by HowEntityByEntityID() for an entity of the dataset.
+// Each record of the entity after the first is a resolution step.
+// Like the resultbuilder documents, it is rendered in canonical form when the CanonicalJSON option of resultbuilder is set.
+func HowEntity(entityID int64) (string, error) {
+	if _, ok := findEntity(entityID); !ok {
+		return "", fmt.Errorf("entity %d is not in the dataset", entityID)
+	}
+	result := resultbuilder.NewHowDoc()
+	first := true
+	step := 0
+	for _, record := range seedRecords {
+		if record.EntityID != entityID {
+			continue
+		}
+		if first {
+			result.Start(resultbuilder.NewVirtualEntity(fmt.Sprintf("V%d", record.InternalID)).
+				Member(record.InternalID, record.DataSourceCode, record.RecordID))
+			first = false
+			continue
+		}
+		inbound := resultbuilder.NewVirtualEntity(fmt.Sprintf("V%d-%s", record.InternalID, record.RecordID)).
+			Member(record.InternalID, record.DataSourceCode, record.RecordID)
+		step++
+		result.Resolve(inbound, fmt.Sprintf("V%d-S%d", entityID, step), record.MatchKey, "")
+	}
+	return result.JSON(), nil
+}
@@ -0,0 +1,195 @@
+package g2config
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ConfigChange is a modification of an in-memory configuration, as returned by ConfigChanges().
+type ConfigChange struct {
+	Operation      string             // "AddDataSource" or "DeleteDataSource".
+	DataSourceCode string             // The DSRC_CODE of the inputJson.
+	Metadata       DataSourceMetadata // The metadata of the inputJson of AddDataSource().
+}
+
+// DataSourceMetadata describes a data source of an in-memory configuration.
+type DataSourceMetadata struct {
+	ID             int64  // DSRC_ID. When adding, 0 assigns the identifier following the highest one.
+	RetentionLevel string // RETENTION_LEVEL, e.g. "Remember".
+	Conversational string // CONVERSATIONAL, e.g. "No".
+}
+
+// The modifications of the in-memory configurations of a G2config, by configuration handle.
+type changeLog struct {
+	mutex   sync.Mutex
+	changes map[uintptr][]ConfigChange
+}
+
+// The inputJson of AddDataSource() and DeleteDataSource().
+type dataSourceInputJson struct {
+	DataSourceCode string `json:"DSRC_CODE"`
+	DataSourceID   int64  `json:"DSRC_ID"`
+	RetentionLevel string `json:"RETENTION_LEVEL"`
+	Conversational string `json:"CONVERSATIONAL"`
+}
+
+// The document returned by ListDataSources().
+type dataSourceJson struct {
+	DataSourceID   int64  `json:"DSRC_ID"`
+	DataSourceCode string `json:"DSRC_CODE"`
+	RetentionLevel string `json:"RETENTION_LEVEL,omitempty"`
+	Conversational string `json:"CONVERSATIONAL,omitempty"`
+}
+
+type dataSourcesJson struct {
+	DataSources []dataSourceJson `json:"DATA_SOURCES"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Append a modification to the log of a handle.
+func (log *changeLog) record(handle uintptr, change ConfigChange) {
+	log.mutex.Lock()
+	defer log.mutex.Unlock()
+	if log.changes == nil {
+		log.changes = map[uintptr][]ConfigChange{}
+	}
+	log.changes[handle] = append(log.changes[handle], change)
+}
+
+// Forget the modifications of a handle, e.g. when its configuration is replaced.
+func (log *changeLog) reset(handle uintptr) {
+	log.mutex.Lock()
+	defer log.mutex.Unlock()
+	delete(log.changes, handle)
+}
+
+// Return the modifications of a handle, in the order they were made.
+func (log *changeLog) get(handle uintptr) []ConfigChange {
+	log.mutex.Lock()
+	defer log.mutex.Unlock()
+	return append([]ConfigChange{}, log.changes[handle]...)
+}
+
+// Return the data sources of the configuration of a handle: ListDataSourcesResult with the modifications made.
+func (client *G2config) dataSources(configHandle uintptr) map[string]DataSourceMetadata {
+	return listedDataSources(applyChanges(client.ListDataSourcesResult, client.changes.get(configHandle)))
+}
+
+// Return an error if a modification does not apply to the data sources of the configuration of a handle.
+func (client *G2config) checkChange(configHandle uintptr, change ConfigChange, inputJson string) error {
+	if len(change.DataSourceCode) == 0 {
+		return client.newError(change.Operation, map[string]string{"configHandle": strconv.FormatUint(uint64(configHandle), 10)}, 4907, inputJson)
+	}
+	dataSources := client.dataSources(configHandle)
+	_, exists := dataSources[change.DataSourceCode]
+	switch change.Operation {
+	case "AddDataSource":
+		if exists {
+			return client.newError(change.Operation, map[string]string{"configHandle": strconv.FormatUint(uint64(configHandle), 10), "dataSourceCode": change.DataSourceCode}, 4904, change.DataSourceCode)
+		}
+		for dataSourceCode, metadata := range dataSources {
+			if change.Metadata.ID != 0 && metadata.ID == change.Metadata.ID {
+				return client.newError(change.Operation, map[string]string{"configHandle": strconv.FormatUint(uint64(configHandle), 10), "dataSourceCode": change.DataSourceCode}, 4906, change.Metadata.ID, dataSourceCode)
+			}
+		}
+	case "DeleteDataSource":
+		if !exists {
+			return client.newError(change.Operation, map[string]string{"configHandle": strconv.FormatUint(uint64(configHandle), 10), "dataSourceCode": change.DataSourceCode}, 4905, change.DataSourceCode)
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Describe the modification made by a call of AddDataSource() or DeleteDataSource().
+func parseChange(operation string, inputJson string) ConfigChange {
+	input := dataSourceInputJson{}
+	_ = json.Unmarshal([]byte(inputJson), &input)
+	result := ConfigChange{
+		Operation:      operation,
+		DataSourceCode: input.DataSourceCode,
+	}
+	if operation == "AddDataSource" {
+		result.Metadata = DataSourceMetadata{
+			ID:             input.DataSourceID,
+			RetentionLevel: input.RetentionLevel,
+			Conversational: input.Conversational,
+		}
+	}
+	return result
+}
+
+// Return the data sources of a ListDataSources() document, by data source code.
+// A document that cannot be parsed has none.
+func listedDataSources(dataSources string) map[string]DataSourceMetadata {
+	document := dataSourcesJson{}
+	_ = json.Unmarshal([]byte(dataSources), &document)
+	result := map[string]DataSourceMetadata{}
+	for _, dataSource := range document.DataSources {
+		result[dataSource.DataSourceCode] = DataSourceMetadata{
+			ID:             dataSource.DataSourceID,
+			RetentionLevel: dataSource.RetentionLevel,
+			Conversational: dataSource.Conversational,
+		}
+	}
+	return result
+}
+
+// Apply modifications to a ListDataSources() document.
+// Added data sources get their DSRC_ID, or the one following the highest DSRC_ID.
+// A document that cannot be parsed is returned unchanged.
+func applyChanges(dataSources string, changes []ConfigChange) string {
+	if len(changes) == 0 {
+		return dataSources
+	}
+	document := dataSourcesJson{}
+	if err := json.Unmarshal([]byte(dataSources), &document); err != nil {
+		return dataSources
+	}
+	for _, change := range changes {
+		index := -1
+		var lastDataSourceID int64 = 0
+		for i, dataSource := range document.DataSources {
+			if dataSource.DataSourceCode == change.DataSourceCode {
+				index = i
+			}
+			if dataSource.DataSourceID > lastDataSourceID {
+				lastDataSourceID = dataSource.DataSourceID
+			}
+		}
+		switch {
+		case change.Operation == "AddDataSource" && index < 0:
+			dataSourceID := change.Metadata.ID
+			if dataSourceID == 0 {
+				dataSourceID = lastDataSourceID + 1
+			}
+			document.DataSources = append(document.DataSources, dataSourceJson{
+				DataSourceID:   dataSourceID,
+				DataSourceCode: change.DataSourceCode,
+				RetentionLevel: change.Metadata.RetentionLevel,
+				Conversational: change.Metadata.Conversational,
+			})
+		case change.Operation == "DeleteDataSource" && index >= 0:
+			document.DataSources = append(document.DataSources[:index], document.DataSources[index+1:]...)
+		}
+	}
+	if document.DataSources == nil {
+		document.DataSources = []dataSourceJson{}
+	}
+	resultBytes, err := json.Marshal(document)
+	if err != nil {
+		return dataSources
+	}
+	return string(resultBytes)
+}
@@ -7,11 +7,16 @@ package g2config
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-mock/internal/observers"
+	"github.com/senzing/g2-sdk-go-mock/isolatedlogger"
+	"github.com/senzing/g2-sdk-go-mock/mockerror"
+	"github.com/senzing/g2-sdk-go-mock/notification"
 	g2configapi "github.com/senzing/g2-sdk-go/g2config"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
@@ -24,13 +29,26 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2config struct {
-	isTrace               bool
+	isTrace               atomic.Bool
 	logger                messagelogger.MessageLoggerInterface
-	observers             subject.Subject
+	loggerMutex           sync.Mutex
+	observers             observers.Registry
 	AddDataSourceResult   string
 	CreateResult          uintptr
 	ListDataSourcesResult string
 	SaveResult            string
+
+	NotificationEncoder notification.Encoder // Encoding of observer notifications. nil is notification.JSON.
+	HandleTTL           time.Duration        // Configuration handles older than this are rejected as expired, except by Close(). 0 never expires.
+	Stateful            bool                 // AddDataSource() and DeleteDataSource() check and report the data sources of the configuration.
+
+	DestroyError            error // Returned by Destroy(), e.g. to test cleanup after a failed shutdown.
+	UnregisterObserverError error // Returned by UnregisterObserver(), which then keeps the observer registered.
+
+	IsolatedLogger bool // Use a logger whose log level is not changed by messagelogger.SetLogLevel(). See the isolatedlogger package.
+
+	handles handleRegistry
+	changes changeLog
 }
 
 // ----------------------------------------------------------------------------
@@ -39,14 +57,35 @@ type G2config struct {
 
 // Get the Logger singleton.
 func (client *G2config) getLogger() messagelogger.MessageLoggerInterface {
+	client.loggerMutex.Lock()
+	defer client.loggerMutex.Unlock()
 	if client.logger == nil {
-		client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, g2configapi.IdMessages, g2configapi.IdStatuses, messagelogger.LevelInfo)
+		if client.IsolatedLogger {
+			client.logger, _ = isolatedlogger.New(ProductId, idMessages(), g2configapi.IdStatuses, messagelogger.LevelInfo)
+		} else {
+			client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, idMessages(), g2configapi.IdStatuses, messagelogger.LevelInfo)
+		}
 	}
 	return client.logger
 }
 
+// Create the error of a message, as a *mockerror.Error describing the method and identifiers of the call.
+func (client *G2config) newError(methodName string, identifiers map[string]string, messageNumber int, details ...interface{}) error {
+	return mockerror.New(client.getLogger().Error(messageNumber, details...), messageNumber, methodName, identifiers)
+}
+
+// Get the registered observers. Returns nil if there are none.
+func (client *G2config) getObservers() subject.Subject {
+	return client.observers.Subject()
+}
+
 // Notify registered observers.
+// Failures are logged, as notifications are usually sent from goroutines that cannot return them.
 func (client *G2config) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	observers := client.getObservers()
+	if observers == nil {
+		return
+	}
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
@@ -54,11 +93,16 @@ func (client *G2config) notify(ctx context.Context, messageId int, err error, de
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	encode := client.NotificationEncoder
+	if encode == nil {
+		encode = notification.JSON
+	}
+	message, err := encode(details)
+	if err == nil {
+		err = observers.NotifyObservers(ctx, message)
+	}
 	if err != nil {
-		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
+		client.getLogger().Log(4901, messageId, err.Error())
 	}
 }
 
@@ -72,6 +116,63 @@ func (client *G2config) traceExit(errorNumber int, details ...interface{}) {
 	client.getLogger().Log(errorNumber, details...)
 }
 
+// ----------------------------------------------------------------------------
+// Mock configuration methods
+// ----------------------------------------------------------------------------
+
+/*
+The SimulateRestart method makes the G2config behave as if the engine had been restarted,
+so code that reconnects or re-initializes can be tested.
+Configuration handles issued by Create() before the restart are rejected by the methods that take a handle.
+*/
+func (client *G2config) SimulateRestart() {
+	client.handles.invalidate()
+}
+
+// ----------------------------------------------------------------------------
+// Mock inspection methods
+// ----------------------------------------------------------------------------
+
+/*
+The LogLevel method returns the log level of the logger of the G2config,
+so that tests can check it with isolatedlogger.CheckLevels().
+
+Output
+  - The log level, e.g. logger.LevelInfo.
+*/
+func (client *G2config) LogLevel() logger.Level {
+	return logger.Level(client.getLogger().GetLogLevel())
+}
+
+/*
+The ConfigChanges method returns the data sources added and deleted through a configuration handle,
+so that code editing a configuration can be checked before it calls Save().
+The log restarts when Create() returns the handle, when Load() replaces its configuration and when it is closed.
+
+Input
+  - configHandle: An identifier of an in-memory configuration.
+
+Output
+  - The AddDataSource() and DeleteDataSource() calls that succeeded, in the order they were made.
+*/
+func (client *G2config) ConfigChanges(configHandle uintptr) []ConfigChange {
+	return client.changes.get(configHandle)
+}
+
+/*
+The DataSources method returns the data sources of the configuration of a handle:
+those of ListDataSourcesResult, with the data sources added and deleted through the handle.
+
+Input
+  - configHandle: An identifier of an in-memory configuration.
+
+Output
+  - The DSRC_ID, RETENTION_LEVEL and CONVERSATIONAL of each data source, by data source code.
+*/
+func (client *G2config) DataSources(configHandle uintptr) map[string]DataSourceMetadata {
+	return client.dataSources(configHandle)
+}
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
@@ -79,6 +180,9 @@ func (client *G2config) traceExit(errorNumber int, details ...interface{}) {
 /*
 The AddDataSource method adds a data source to an existing in-memory configuration.
 The configHandle is created by the Create() method.
+If Stateful is set, the mock rejects a DSRC_CODE or DSRC_ID already in the configuration
+and returns the DSRC_ID assigned instead of AddDataSourceResult.
+The inputJson may also set "DSRC_ID", "RETENTION_LEVEL" and "CONVERSATIONAL", as reported by ListDataSources().
 
 Input
   - ctx: A context to control lifecycle.
@@ -90,24 +194,38 @@ Output
     See the example output.
 */
 func (client *G2config) AddDataSource(ctx context.Context, configHandle uintptr, inputJson string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(1, configHandle, inputJson)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkHandle("AddDataSource", configHandle)
+	result := client.AddDataSourceResult
+	if err == nil {
+		change := parseChange("AddDataSource", inputJson)
+		if client.Stateful {
+			err = client.checkChange(configHandle, change, inputJson)
+		}
+		if err == nil {
+			client.changes.record(configHandle, change)
+		}
+		if err == nil && client.Stateful {
+			result = fmt.Sprintf(`{"DSRC_ID":%d}`, client.dataSources(configHandle)[change.DataSourceCode].ID)
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"inputJson": inputJson,
-				"return":    client.AddDataSourceResult,
+				"return":    result,
 			}
 			client.notify(ctx, 8001, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(2, configHandle, inputJson, client.AddDataSourceResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(2, configHandle, inputJson, result, err, time.Since(entryTime))
 	}
-	return client.AddDataSourceResult, err
+	return result, err
 }
 
 /*
@@ -119,18 +237,25 @@ Input
   - configHandle: An identifier of an in-memory configuration.
 */
 func (client *G2config) Close(ctx context.Context, configHandle uintptr) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(5, configHandle)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if !client.handles.valid(configHandle) {
+		err = client.newError("Close", map[string]string{"configHandle": strconv.FormatUint(uint64(configHandle), 10)}, 4902, configHandle)
+	}
+	if err == nil {
+		client.handles.close(configHandle)
+		client.changes.reset(configHandle)
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8002, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(6, configHandle, err, time.Since(entryTime))
 	}
 	return err
@@ -150,18 +275,20 @@ Output
   - A Pointer to an in-memory Senzing configuration.
 */
 func (client *G2config) Create(ctx context.Context) (uintptr, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(7)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	client.handles.issue(client.CreateResult)
+	client.changes.reset(client.CreateResult)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8003, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(8, client.CreateResult, err, time.Since(entryTime))
 	}
 	return client.CreateResult, err
@@ -170,6 +297,7 @@ func (client *G2config) Create(ctx context.Context) (uintptr, error) {
 /*
 The DeleteDataSource method removes a data source from an existing configuration.
 The configHandle is created by the Create() method.
+If Stateful is set, the mock rejects a DSRC_CODE that is not in the configuration.
 
 Input
   - ctx: A context to control lifecycle.
@@ -177,12 +305,22 @@ Input
   - inputJson: A JSON document in the format `{"DSRC_CODE": "NAME_OF_DATASOURCE"}`.
 */
 func (client *G2config) DeleteDataSource(ctx context.Context, configHandle uintptr, inputJson string) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(9, configHandle, inputJson)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkHandle("DeleteDataSource", configHandle)
+	if err == nil {
+		change := parseChange("DeleteDataSource", inputJson)
+		if client.Stateful {
+			err = client.checkChange(configHandle, change, inputJson)
+		}
+		if err == nil {
+			client.changes.record(configHandle, change)
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"inputJson": inputJson,
@@ -190,7 +328,7 @@ func (client *G2config) DeleteDataSource(ctx context.Context, configHandle uintp
 			client.notify(ctx, 8004, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(10, configHandle, inputJson, err, time.Since(entryTime))
 	}
 	return err
@@ -204,18 +342,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2config) Destroy(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(11)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.DestroyError
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8005, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(12, err, time.Since(entryTime))
 	}
 	return err
@@ -230,18 +369,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2config) GetSdkId(ctx context.Context) string {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(31)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8010, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(32, err, time.Since(entryTime))
 	}
 	return "mock"
@@ -258,12 +397,12 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2config) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(17, moduleName, iniParams, verboseLogging)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
@@ -273,7 +412,7 @@ func (client *G2config) Init(ctx context.Context, moduleName string, iniParams s
 			client.notify(ctx, 8006, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(18, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -282,6 +421,7 @@ func (client *G2config) Init(ctx context.Context, moduleName string, iniParams s
 /*
 The ListDataSources method returns a JSON document of data sources.
 The configHandle is created by the Create() method.
+The mock returns ListDataSourcesResult with the data sources added and deleted through the configHandle.
 
 Input
   - ctx: A context to control lifecycle.
@@ -292,21 +432,23 @@ Output
     See the example output.
 */
 func (client *G2config) ListDataSources(ctx context.Context, configHandle uintptr) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(19, configHandle)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkHandle("ListDataSources", configHandle)
+	result := applyChanges(client.ListDataSourcesResult, client.changes.get(configHandle))
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8007, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(20, configHandle, client.ListDataSourcesResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(20, configHandle, result, err, time.Since(entryTime))
 	}
-	return client.ListDataSourcesResult, err
+	return result, err
 }
 
 /*
@@ -319,18 +461,22 @@ Input
   - jsonConfig: A JSON document containing the Senzing configuration.
 */
 func (client *G2config) Load(ctx context.Context, configHandle uintptr, jsonConfig string) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(21, configHandle, jsonConfig)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkHandle("Load", configHandle)
+	if err == nil {
+		client.changes.reset(configHandle)
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8008, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(22, configHandle, jsonConfig, err, time.Since(entryTime))
 	}
 	return err
@@ -344,15 +490,12 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2config) RegisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(27, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
-	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
-	}
-	err := client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
+	err := client.observers.Register(ctx, observer)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"observerID": observer.GetObserverId(ctx),
@@ -360,7 +503,7 @@ func (client *G2config) RegisterObserver(ctx context.Context, observer observer.
 			client.notify(ctx, 8011, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(28, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -379,18 +522,19 @@ Output
     See the example output.
 */
 func (client *G2config) Save(ctx context.Context, configHandle uintptr) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(23, configHandle)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkHandle("Save", configHandle)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8009, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(24, configHandle, client.SaveResult, err, time.Since(entryTime))
 	}
 	return client.SaveResult, err
@@ -404,14 +548,14 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2config) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(25, logLevel)
 	}
 	var err error = nil
 	entryTime := time.Now()
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
-	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
-	if client.observers != nil {
+	client.isTrace.Store(client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"logLevel": logger.LevelToTextMap[logLevel],
@@ -419,7 +563,7 @@ func (client *G2config) SetLogLevel(ctx context.Context, logLevel logger.Level)
 			client.notify(ctx, 8012, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(26, logLevel, err, time.Since(entryTime))
 	}
 	return err
@@ -433,26 +577,26 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2config) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(29, observer.GetObserverId(ctx))
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.UnregisterObserverError
+	if client.getObservers() != nil {
 		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
+		// client.notify is called synchronously before the observer is unregistered.
 		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// Then the observer may be unregistered, but observer goroutines will be OK.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8013, err, details)
 	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+	if err == nil {
+		err = client.observers.Unregister(ctx, observer)
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(30, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
 	"github.com/senzing/g2-sdk-go/g2api"
 	"github.com/senzing/go-logging/logger"
+	"github.com/senzing/go-observing/observer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -190,6 +192,118 @@ func TestG2config_Init(test *testing.T) {
 	testError(test, ctx, g2config, err)
 }
 
+func TestG2config_UnregisterObserver_empty(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &G2config{}
+	observer := &observer.ObserverNull{
+		Id: "Observer 1",
+	}
+	err := g2config.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2config, err)
+}
+
+func TestG2config_SimulateRestart(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &G2config{CreateResult: 1}
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	g2config.SimulateRestart()
+	_, err = g2config.Save(ctx, configHandle)
+	assert.Error(test, err)
+	err = g2config.Close(ctx, configHandle)
+	assert.Error(test, err)
+	configHandle, err = g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+}
+
+func TestG2config_Save_handleExpiration(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &G2config{
+		CreateResult: 1,
+		HandleTTL:    10 * time.Millisecond,
+	}
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	_, err = g2config.Save(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = g2config.Save(ctx, configHandle)
+	assert.ErrorContains(test, err, "expired")
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+}
+
+func TestG2config_ConfigChanges(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &G2config{
+		CreateResult:          1,
+		ListDataSourcesResult: `{"DATA_SOURCES":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":2,"DSRC_CODE":"SEARCH"}]}`,
+	}
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	_, err = g2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "CUSTOMERS"}`)
+	testError(test, ctx, g2config, err)
+	err = g2config.DeleteDataSource(ctx, configHandle, `{"DSRC_CODE": "TEST"}`)
+	testError(test, ctx, g2config, err)
+	expected := []ConfigChange{
+		{Operation: "AddDataSource", DataSourceCode: "CUSTOMERS"},
+		{Operation: "DeleteDataSource", DataSourceCode: "TEST"},
+	}
+	assert.Equal(test, expected, g2config.ConfigChanges(configHandle))
+	actual, err := g2config.ListDataSources(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+	assert.JSONEq(test, `{"DATA_SOURCES":[{"DSRC_ID":2,"DSRC_CODE":"SEARCH"},{"DSRC_ID":3,"DSRC_CODE":"CUSTOMERS"}]}`, actual)
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+	assert.Empty(test, g2config.ConfigChanges(configHandle))
+	configHandle, err = g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	actual, err = g2config.ListDataSources(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+	assert.Equal(test, g2config.ListDataSourcesResult, actual)
+}
+
+func TestG2config_DeleteDataSource_stateful(test *testing.T) {
+	ctx := context.TODO()
+	g2config := &G2config{
+		CreateResult:          1,
+		ListDataSourcesResult: `{"DATA_SOURCES":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":2,"DSRC_CODE":"SEARCH"}]}`,
+		Stateful:              true,
+	}
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	err = g2config.DeleteDataSource(ctx, configHandle, `{"DSRC_CODE": "CUSTOMERS"}`)
+	assert.ErrorContains(test, err, "[CUSTOMERS] does not exist")
+	actual, err := g2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "CUSTOMERS", "RETENTION_LEVEL": "Remember"}`)
+	testError(test, ctx, g2config, err)
+	assert.Equal(test, `{"DSRC_ID":3}`, actual)
+	_, err = g2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "CUSTOMERS"}`)
+	assert.ErrorContains(test, err, "[CUSTOMERS] already exists")
+	_, err = g2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "WATCHLIST", "DSRC_ID": 3}`)
+	assert.ErrorContains(test, err, "[CUSTOMERS]")
+	_, err = g2config.AddDataSource(ctx, configHandle, `{"DSRC_ID": 4}`)
+	assert.ErrorContains(test, err, "DSRC_CODE is required")
+	actual, err = g2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "WATCHLIST", "DSRC_ID": 1001, "CONVERSATIONAL": "No"}`)
+	testError(test, ctx, g2config, err)
+	assert.Equal(test, `{"DSRC_ID":1001}`, actual)
+	err = g2config.DeleteDataSource(ctx, configHandle, `{"DSRC_CODE": "TEST"}`)
+	testError(test, ctx, g2config, err)
+	expected := map[string]DataSourceMetadata{
+		"SEARCH":    {ID: 2},
+		"CUSTOMERS": {ID: 3, RetentionLevel: "Remember"},
+		"WATCHLIST": {ID: 1001, Conversational: "No"},
+	}
+	assert.Equal(test, expected, g2config.DataSources(configHandle))
+	actual, err = g2config.ListDataSources(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+	assert.Contains(test, actual, `{"DSRC_ID":3,"DSRC_CODE":"CUSTOMERS","RETENTION_LEVEL":"Remember"}`)
+	assert.Len(test, g2config.ConfigChanges(configHandle), 3)
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+}
+
 func TestG2config_Destroy(test *testing.T) {
 	ctx := context.TODO()
 	g2config := getTestObject(ctx, test)
@@ -1,8 +1,51 @@
 package g2config
 
+import (
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2configapi "github.com/senzing/g2-sdk-go/g2config"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2config package found messages having the format "senzing-6031xxxx".
 const ProductId = 6031
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Messages for errors reported by the mock, in addition to those of g2configapi.IdMessages.
+var mockIdMessages = map[int]string{
+	4901: "Notification %d could not be delivered to observers: %s",
+	4902: "Handle %d is not valid. It was issued before the engine was restarted.",
+	4903: "Handle %d has expired: %s.",
+	4904: "Data source code [%s] already exists.",
+	4905: "Data source code [%s] does not exist.",
+	4906: "Data source ID [%d] is already used by data source code [%s].",
+	4907: "Invalid inputJson: %s. DSRC_CODE is required.",
+}
+
+// ----------------------------------------------------------------------------
+// Interface assertions
+// ----------------------------------------------------------------------------
+
+// G2config can be used wherever the g2-sdk-go-base or g2-sdk-go-grpc implementation is used.
+var _ g2api.G2config = &G2config{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Combine g2configapi.IdMessages and mockIdMessages.
+func idMessages() map[int]string {
+	result := map[int]string{}
+	for id, message := range g2configapi.IdMessages {
+		result[id] = message
+	}
+	for id, message := range mockIdMessages {
+		result[id] = message
+	}
+	return result
+}
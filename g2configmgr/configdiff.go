@@ -0,0 +1,114 @@
+package g2configmgr
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ConfigDiff describes the differences between two configurations, as returned by Diff().
+// Each list is sorted.
+type ConfigDiff struct {
+	DataSourcesAdded   []string // DSRC_CODE of data sources only in the second configuration.
+	DataSourcesRemoved []string // DSRC_CODE of data sources only in the first configuration.
+	FeaturesAdded      []string // FTYPE_CODE of feature types only in the second configuration.
+	FeaturesRemoved    []string // FTYPE_CODE of feature types only in the first configuration.
+	FeaturesChanged    []string // FTYPE_CODE of feature types defined differently in the two configurations.
+}
+
+// The parts of a configuration compared by Diff().
+type configJson struct {
+	G2Config struct {
+		DataSources []map[string]json.RawMessage `json:"CFG_DSRC"`
+		Features    []map[string]json.RawMessage `json:"CFG_FTYPE"`
+	} `json:"G2_CONFIG"`
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// IsEmpty reports whether the two configurations have the same data sources and feature types.
+func (diff ConfigDiff) IsEmpty() bool {
+	return len(diff.DataSourcesAdded)+len(diff.DataSourcesRemoved)+len(diff.FeaturesAdded)+len(diff.FeaturesRemoved)+len(diff.FeaturesChanged) == 0
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Index the entries of a configuration table by their code, e.g. "DSRC_CODE".
+// The value of each entry is its JSON document, with sorted keys.
+func indexByCode(entries []map[string]json.RawMessage, codeKey string) map[string]string {
+	result := map[string]string{}
+	for _, entry := range entries {
+		code := ""
+		_ = json.Unmarshal(entry[codeKey], &code)
+		entryBytes, _ := json.Marshal(entry)
+		result[code] = string(entryBytes)
+	}
+	return result
+}
+
+// Return the codes only in the first index, the codes only in the second and the codes with different entries.
+func diffIndexes(index1 map[string]string, index2 map[string]string) ([]string, []string, []string) {
+	added := []string{}
+	removed := []string{}
+	changed := []string{}
+	for code, entry1 := range index1 {
+		entry2, ok := index2[code]
+		switch {
+		case !ok:
+			removed = append(removed, code)
+		case entry1 != entry2:
+			changed = append(changed, code)
+		}
+	}
+	for code := range index2 {
+		if _, ok := index1[code]; !ok {
+			added = append(added, code)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return removed, added, changed
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Diff function compares the data sources (CFG_DSRC) and feature types (CFG_FTYPE) of two configurations.
+Data sources are identified by DSRC_CODE and feature types by FTYPE_CODE.
+
+Input
+  - config1: The JSON document of the first configuration, e.g. the configuration in use.
+  - config2: The JSON document of the second configuration, e.g. a proposed configuration.
+
+Output
+  - The changes from config1 to config2.
+  - An error if either configuration is not a JSON document.
+*/
+func Diff(config1 string, config2 string) (ConfigDiff, error) {
+	document1 := configJson{}
+	if err := json.Unmarshal([]byte(config1), &document1); err != nil {
+		return ConfigDiff{}, err
+	}
+	document2 := configJson{}
+	if err := json.Unmarshal([]byte(config2), &document2); err != nil {
+		return ConfigDiff{}, err
+	}
+	result := ConfigDiff{}
+	result.DataSourcesRemoved, result.DataSourcesAdded, _ = diffIndexes(
+		indexByCode(document1.G2Config.DataSources, "DSRC_CODE"),
+		indexByCode(document2.G2Config.DataSources, "DSRC_CODE"))
+	result.FeaturesRemoved, result.FeaturesAdded, result.FeaturesChanged = diffIndexes(
+		indexByCode(document1.G2Config.Features, "FTYPE_CODE"),
+		indexByCode(document2.G2Config.Features, "FTYPE_CODE"))
+	return result, nil
+}
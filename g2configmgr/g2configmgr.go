@@ -7,11 +7,19 @@ package g2configmgr
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"fmt"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-mock/internal/observers"
+	"github.com/senzing/g2-sdk-go-mock/isolatedlogger"
+	"github.com/senzing/g2-sdk-go-mock/mockerror"
+	"github.com/senzing/g2-sdk-go-mock/notification"
+	"github.com/senzing/g2-sdk-go-mock/recorder"
 	g2configmgrapi "github.com/senzing/g2-sdk-go/g2configmgr"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
@@ -24,13 +32,24 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2configmgr struct {
-	isTrace                  bool
+	isTrace                  atomic.Bool
 	logger                   messagelogger.MessageLoggerInterface
-	observers                subject.Subject
+	loggerMutex              sync.Mutex
+	observers                observers.Registry
+	defaultConfigIDMutex     sync.RWMutex
 	AddConfigResult          int64
 	GetConfigResult          string
 	GetConfigListResult      string
 	GetDefaultConfigIDResult int64
+
+	NotificationEncoder notification.Encoder // Encoding of observer notifications. nil is notification.JSON.
+	Recorder            *recorder.Recorder   // If set, captures each call of an interface method.
+	Configs             map[int64]string     // Configurations returned by GetConfig() and compared by DiffConfigs(), by configuration ID. Others are GetConfigResult.
+
+	DestroyError            error // Returned by Destroy(), e.g. to test cleanup after a failed shutdown.
+	UnregisterObserverError error // Returned by UnregisterObserver(), which then keeps the observer registered.
+
+	IsolatedLogger bool // Use a logger whose log level is not changed by messagelogger.SetLogLevel(). See the isolatedlogger package.
 }
 
 // ----------------------------------------------------------------------------
@@ -39,14 +58,41 @@ type G2configmgr struct {
 
 // Get the Logger singleton.
 func (client *G2configmgr) getLogger() messagelogger.MessageLoggerInterface {
+	client.loggerMutex.Lock()
+	defer client.loggerMutex.Unlock()
 	if client.logger == nil {
-		client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, g2configmgrapi.IdMessages, g2configmgrapi.IdStatuses, messagelogger.LevelInfo)
+		if client.IsolatedLogger {
+			client.logger, _ = isolatedlogger.New(ProductId, idMessages(), g2configmgrapi.IdStatuses, messagelogger.LevelInfo)
+		} else {
+			client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, idMessages(), g2configmgrapi.IdStatuses, messagelogger.LevelInfo)
+		}
 	}
 	return client.logger
 }
 
+// Describe a configuration document by its SHA-256 hash and size, so observers can detect drift.
+func configDigest(configStr string) (string, string) {
+	hash := sha256.Sum256([]byte(configStr))
+	return hex.EncodeToString(hash[:]), strconv.Itoa(len(configStr))
+}
+
+// Create the error of a message, as a *mockerror.Error describing the method and identifiers of the call.
+func (client *G2configmgr) newError(methodName string, identifiers map[string]string, messageNumber int, details ...interface{}) error {
+	return mockerror.New(client.getLogger().Error(messageNumber, details...), messageNumber, methodName, identifiers)
+}
+
+// Get the registered observers. Returns nil if there are none.
+func (client *G2configmgr) getObservers() subject.Subject {
+	return client.observers.Subject()
+}
+
 // Notify registered observers.
+// Failures are logged, as notifications are usually sent from goroutines that cannot return them.
 func (client *G2configmgr) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	observers := client.getObservers()
+	if observers == nil {
+		return
+	}
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
@@ -54,11 +100,16 @@ func (client *G2configmgr) notify(ctx context.Context, messageId int, err error,
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	encode := client.NotificationEncoder
+	if encode == nil {
+		encode = notification.JSON
+	}
+	message, err := encode(details)
+	if err == nil {
+		err = observers.NotifyObservers(ctx, message)
+	}
 	if err != nil {
-		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
+		client.getLogger().Log(4901, messageId, err.Error())
 	}
 }
 
@@ -72,6 +123,46 @@ func (client *G2configmgr) traceExit(errorNumber int, details ...interface{}) {
 	client.getLogger().Log(errorNumber, details...)
 }
 
+// ----------------------------------------------------------------------------
+// Mock inspection methods
+// ----------------------------------------------------------------------------
+
+/*
+The LogLevel method returns the log level of the logger of the G2configmgr,
+so that tests can check it with isolatedlogger.CheckLevels().
+
+Output
+  - The log level, e.g. logger.LevelInfo.
+*/
+func (client *G2configmgr) LogLevel() logger.Level {
+	return logger.Level(client.getLogger().GetLogLevel())
+}
+
+/*
+The DiffConfigs method compares two configurations of Configs with Diff(),
+so that tooling reviewing configuration changes can be tested.
+
+Input
+  - configID1: The configuration ID of the first configuration, e.g. the default configuration.
+  - configID2: The configuration ID of the second configuration.
+
+Output
+  - The data sources and feature types added, removed and changed from the first configuration to the second.
+  - An error if a configuration ID is not in Configs, or its configuration is not a JSON document.
+*/
+func (client *G2configmgr) DiffConfigs(configID1 int64, configID2 int64) (ConfigDiff, error) {
+	for _, configID := range []int64{configID1, configID2} {
+		config, ok := client.Configs[configID]
+		if !ok {
+			return ConfigDiff{}, client.newError("DiffConfigs", map[string]string{"configID": strconv.FormatInt(configID, 10)}, 4902, configID)
+		}
+		if !json.Valid([]byte(config)) {
+			return ConfigDiff{}, client.newError("DiffConfigs", map[string]string{"configID": strconv.FormatInt(configID, 10)}, 4903, configID)
+		}
+	}
+	return Diff(client.Configs[configID1], client.Configs[configID2])
+}
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
@@ -86,22 +177,28 @@ Input
 
 Output
   - A configuration identifier.
+
+Observers are notified with the "configHash" (SHA-256, hex) and "configSize" (bytes) of configStr.
 */
 func (client *G2configmgr) AddConfig(ctx context.Context, configStr string, configComments string) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(1, configStr, configComments)
 	}
+	client.Recorder.Record("g2configmgr", "AddConfig", configStr, configComments)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
+			configHash, configSize := configDigest(configStr)
 			details := map[string]string{
 				"configComments": configComments,
+				"configHash":     configHash,
+				"configSize":     configSize,
 			}
 			client.notify(ctx, 8001, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(2, configStr, configComments, client.AddConfigResult, err, time.Since(entryTime))
 	}
 	return client.AddConfigResult, err
@@ -115,18 +212,20 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2configmgr) Destroy(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(5)
 	}
+	client.Recorder.Record("g2configmgr", "Destroy")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.DestroyError
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8002, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(6, err, time.Since(entryTime))
 	}
 	return err
@@ -134,6 +233,7 @@ func (client *G2configmgr) Destroy(ctx context.Context) error {
 
 /*
 The GetConfig method retrieves a specific Senzing configuration JSON document from the Senzing database.
+The mock returns the configuration of Configs for the configID, or GetConfigResult.
 
 Input
   - ctx: A context to control lifecycle.
@@ -144,21 +244,26 @@ Output
     See the example output.
 */
 func (client *G2configmgr) GetConfig(ctx context.Context, configID int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(7, configID)
 	}
+	client.Recorder.Record("g2configmgr", "GetConfig", configID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.GetConfigResult
+	if config, ok := client.Configs[configID]; ok {
+		result = config
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8003, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(8, configID, client.GetConfigResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(8, configID, result, err, time.Since(entryTime))
 	}
-	return client.GetConfigResult, err
+	return result, err
 }
 
 /*
@@ -172,18 +277,19 @@ Output
     See the example output.
 */
 func (client *G2configmgr) GetConfigList(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(9)
 	}
+	client.Recorder.Record("g2configmgr", "GetConfigList")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8004, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(10, client.GetConfigListResult, err, time.Since(entryTime))
 	}
 	return client.GetConfigListResult, err
@@ -199,21 +305,25 @@ Output
   - A configuration identifier which identifies the current configuration in use.
 */
 func (client *G2configmgr) GetDefaultConfigID(ctx context.Context) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(11)
 	}
+	client.Recorder.Record("g2configmgr", "GetDefaultConfigID")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	client.defaultConfigIDMutex.RLock()
+	result := client.GetDefaultConfigIDResult
+	client.defaultConfigIDMutex.RUnlock()
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8005, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(12, client.GetDefaultConfigIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(12, result, err, time.Since(entryTime))
 	}
-	return client.GetDefaultConfigIDResult, err
+	return result, err
 }
 
 /*
@@ -225,18 +335,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2configmgr) GetSdkId(ctx context.Context) string {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(29)
 	}
+	client.Recorder.Record("g2configmgr", "GetSdkId")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8010, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(30, err, time.Since(entryTime))
 	}
 	return "mock"
@@ -253,12 +364,13 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2configmgr) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(17, moduleName, iniParams, verboseLogging)
 	}
+	client.Recorder.Record("g2configmgr", "Init", moduleName, iniParams, verboseLogging)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
@@ -268,7 +380,7 @@ func (client *G2configmgr) Init(ctx context.Context, moduleName string, iniParam
 			client.notify(ctx, 8006, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(18, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -282,15 +394,13 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2configmgr) RegisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(25, observer.GetObserverId(ctx))
 	}
+	client.Recorder.Record("g2configmgr", "RegisterObserver", observer.GetObserverId(ctx))
 	entryTime := time.Now()
-	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
-	}
-	err := client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
+	err := client.observers.Register(ctx, observer)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"observerID": observer.GetObserverId(ctx),
@@ -298,7 +408,7 @@ func (client *G2configmgr) RegisterObserver(ctx context.Context, observer observ
 			client.notify(ctx, 8010, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(26, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -308,6 +418,7 @@ func (client *G2configmgr) RegisterObserver(ctx context.Context, observer observ
 The ReplaceDefaultConfigID method replaces the old configuration identifier with a new configuration identifier in the Senzing database.
 It is like a "compare-and-swap" instruction to serialize concurrent editing of configuration.
 If oldConfigID is no longer the "old configuration identifier", the operation will fail.
+In the mock, the comparison and replacement of GetDefaultConfigIDResult happen under a single lock.
 To simply set the default configuration ID, use SetDefaultConfigID().
 
 Input
@@ -316,12 +427,20 @@ Input
   - newConfigID: The configuration identifier to use as the default.
 */
 func (client *G2configmgr) ReplaceDefaultConfigID(ctx context.Context, oldConfigID int64, newConfigID int64) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(19, oldConfigID, newConfigID)
 	}
+	client.Recorder.Record("g2configmgr", "ReplaceDefaultConfigID", oldConfigID, newConfigID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	client.defaultConfigIDMutex.Lock()
+	if client.GetDefaultConfigIDResult != oldConfigID {
+		err = client.newError("ReplaceDefaultConfigID", map[string]string{"oldConfigID": strconv.FormatInt(oldConfigID, 10), "newConfigID": strconv.FormatInt(newConfigID, 10)}, 4008, oldConfigID, newConfigID, -1)
+	} else {
+		client.GetDefaultConfigIDResult = newConfigID
+	}
+	client.defaultConfigIDMutex.Unlock()
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"newConfigID": strconv.FormatInt(newConfigID, 10),
@@ -329,7 +448,7 @@ func (client *G2configmgr) ReplaceDefaultConfigID(ctx context.Context, oldConfig
 			client.notify(ctx, 8007, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(20, oldConfigID, newConfigID, err, time.Since(entryTime))
 	}
 	return err
@@ -338,26 +457,35 @@ func (client *G2configmgr) ReplaceDefaultConfigID(ctx context.Context, oldConfig
 /*
 The SetDefaultConfigID method replaces the sets a new configuration identifier in the Senzing database.
 To serialize modifying of the configuration identifier, see ReplaceDefaultConfigID().
+In the mock, the new value is stored in GetDefaultConfigIDResult and is safe to set while other goroutines call GetDefaultConfigID().
+Observers are notified with the "configHash" and "configSize" of GetConfigResult, the document the mock serves for the configuration.
 
 Input
   - ctx: A context to control lifecycle.
   - configID: The configuration identifier of the Senzing Engine configuration to use as the default.
 */
 func (client *G2configmgr) SetDefaultConfigID(ctx context.Context, configID int64) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(21, configID)
 	}
+	client.Recorder.Record("g2configmgr", "SetDefaultConfigID", configID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	client.defaultConfigIDMutex.Lock()
+	client.GetDefaultConfigIDResult = configID
+	client.defaultConfigIDMutex.Unlock()
+	if client.getObservers() != nil {
 		go func() {
+			configHash, configSize := configDigest(client.GetConfigResult)
 			details := map[string]string{
-				"configID": strconv.FormatInt(configID, 10),
+				"configHash": configHash,
+				"configID":   strconv.FormatInt(configID, 10),
+				"configSize": configSize,
 			}
 			client.notify(ctx, 8008, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(22, configID, err, time.Since(entryTime))
 	}
 	return err
@@ -371,14 +499,15 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2configmgr) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(23, logLevel)
 	}
+	client.Recorder.Record("g2configmgr", "SetLogLevel", logLevel)
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
-	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
-	if client.observers != nil {
+	client.isTrace.Store(client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"logLevel": logger.LevelToTextMap[logLevel],
@@ -386,7 +515,7 @@ func (client *G2configmgr) SetLogLevel(ctx context.Context, logLevel logger.Leve
 			client.notify(ctx, 8011, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(24, logLevel, err, time.Since(entryTime))
 	}
 	return err
@@ -400,26 +529,27 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2configmgr) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(27, observer.GetObserverId(ctx))
 	}
+	client.Recorder.Record("g2configmgr", "UnregisterObserver", observer.GetObserverId(ctx))
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
+	err = client.UnregisterObserverError
+	if client.getObservers() != nil {
 		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
+		// client.notify is called synchronously before the observer is unregistered.
 		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// Then the observer may be unregistered, but observer goroutines will be OK.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8012, err, details)
 	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+	if err == nil {
+		err = client.observers.Unregister(ctx, observer)
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(28, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
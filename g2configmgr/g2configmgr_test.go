@@ -2,8 +2,12 @@ package g2configmgr
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -22,6 +26,23 @@ var (
 	g2configmgrSingleton *G2configmgr
 )
 
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type testObserver struct {
+	id       string
+	messages chan string
+}
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return observer.id
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	observer.messages <- message
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -56,6 +77,26 @@ func printActual(test *testing.T, actual interface{}) {
 	printResult(test, "Actual", actual)
 }
 
+func waitForMessage(test *testing.T, observer *testObserver, messageId string) map[string]string {
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case message := <-observer.messages:
+			details := map[string]string{}
+			err := json.Unmarshal([]byte(message), &details)
+			if err != nil {
+				assert.FailNow(test, err.Error())
+			}
+			if details["messageId"] == messageId {
+				return details
+			}
+		case <-timeout:
+			assert.FailNow(test, "No notification with messageId "+messageId)
+			return nil
+		}
+	}
+}
+
 func testError(test *testing.T, ctx context.Context, g2configmgr g2api.G2configmgr, err error) {
 	if err != nil {
 		test.Log("Error:", err.Error())
@@ -106,6 +147,24 @@ func TestG2configmgr_AddConfig(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2configmgr_AddConfig_notification(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &G2configmgr{}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2configmgr.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2configmgr, err)
+	configStr := `{"G2_CONFIG":{}}`
+	_, err = g2configmgr.AddConfig(ctx, configStr, "Test comment")
+	testError(test, ctx, g2configmgr, err)
+	details := waitForMessage(test, observer, "8001")
+	hash := sha256.Sum256([]byte(configStr))
+	assert.Equal(test, hex.EncodeToString(hash[:]), details["configHash"])
+	assert.Equal(test, fmt.Sprintf("%d", len(configStr)), details["configSize"])
+}
+
 func TestG2configmgr_GetConfig(test *testing.T) {
 	ctx := context.TODO()
 	g2configmgr := getTestObject(ctx, test)
@@ -168,6 +227,83 @@ func TestG2configmgr_SetDefaultConfigID(test *testing.T) {
 	testError(test, ctx, g2configmgr, err)
 }
 
+func TestG2configmgr_ReplaceDefaultConfigID_mismatch(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &G2configmgr{
+		GetDefaultConfigIDResult: 1,
+	}
+	err := g2configmgr.ReplaceDefaultConfigID(ctx, 2, 3)
+	assert.Error(test, err)
+	actual, err := g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, g2configmgr, err)
+	assert.Equal(test, int64(1), actual)
+	err = g2configmgr.ReplaceDefaultConfigID(ctx, 1, 3)
+	testError(test, ctx, g2configmgr, err)
+	actual, err = g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, g2configmgr, err)
+	assert.Equal(test, int64(3), actual)
+}
+
+func TestG2configmgr_SetDefaultConfigID_notification(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &G2configmgr{
+		GetConfigResult: `{"G2_CONFIG":{"CFG_DSRC":[]}}`,
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2configmgr.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2configmgr, err)
+	err = g2configmgr.SetDefaultConfigID(ctx, 2)
+	testError(test, ctx, g2configmgr, err)
+	details := waitForMessage(test, observer, "8008")
+	hash := sha256.Sum256([]byte(g2configmgr.GetConfigResult))
+	assert.Equal(test, hex.EncodeToString(hash[:]), details["configHash"])
+	assert.Equal(test, fmt.Sprintf("%d", len(g2configmgr.GetConfigResult)), details["configSize"])
+	assert.Equal(test, "2", details["configID"])
+}
+
+func TestG2configmgr_SetDefaultConfigID_concurrent(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &G2configmgr{}
+	var waitGroup sync.WaitGroup
+	for i := 1; i <= 100; i++ {
+		waitGroup.Add(2)
+		go func(configID int64) {
+			defer waitGroup.Done()
+			err := g2configmgr.SetDefaultConfigID(ctx, configID)
+			testError(test, ctx, g2configmgr, err)
+		}(int64(i))
+		go func() {
+			defer waitGroup.Done()
+			_, err := g2configmgr.GetDefaultConfigID(ctx)
+			testError(test, ctx, g2configmgr, err)
+		}()
+	}
+	waitGroup.Wait()
+	actual, err := g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, g2configmgr, err)
+	assert.Greater(test, actual, int64(0))
+}
+
+func TestG2configmgr_UnregisterObserver_empty(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &G2configmgr{}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2configmgr.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2configmgr, err)
+	err = g2configmgr.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2configmgr, err)
+	err = g2configmgr.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2configmgr, err)
+	err = g2configmgr.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2configmgr, err)
+}
+
 func TestG2configmgr_Init(test *testing.T) {
 	ctx := context.TODO()
 	g2configmgr := getTestObject(ctx, test)
@@ -178,6 +314,38 @@ func TestG2configmgr_Init(test *testing.T) {
 	testError(test, ctx, g2configmgr, err)
 }
 
+func TestG2configmgr_DiffConfigs(test *testing.T) {
+	ctx := context.TODO()
+	g2configmgr := &G2configmgr{
+		Configs: map[int64]string{
+			1: `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":2,"DSRC_CODE":"SEARCH"}],"CFG_FTYPE":[{"FTYPE_ID":1,"FTYPE_CODE":"NAME","FTYPE_FREQ":"NAME"},{"FTYPE_ID":2,"FTYPE_CODE":"DOB","FTYPE_FREQ":"FM"}]}}`,
+			2: `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":1001,"DSRC_CODE":"CUSTOMERS"}],"CFG_FTYPE":[{"FTYPE_CODE":"NAME", "FTYPE_ID":1, "FTYPE_FREQ":"NAME"},{"FTYPE_ID":2,"FTYPE_CODE":"DOB","FTYPE_FREQ":"FF"},{"FTYPE_ID":3,"FTYPE_CODE":"EMAIL","FTYPE_FREQ":"F1"}]}}`,
+			3: `{"G2_CONFIG":`,
+		},
+	}
+	actual, err := g2configmgr.DiffConfigs(1, 2)
+	testError(test, ctx, g2configmgr, err)
+	expected := ConfigDiff{
+		DataSourcesAdded:   []string{"CUSTOMERS"},
+		DataSourcesRemoved: []string{"SEARCH"},
+		FeaturesAdded:      []string{"EMAIL"},
+		FeaturesRemoved:    []string{},
+		FeaturesChanged:    []string{"DOB"},
+	}
+	assert.Equal(test, expected, actual)
+	assert.False(test, actual.IsEmpty())
+	actual, err = g2configmgr.DiffConfigs(2, 2)
+	testError(test, ctx, g2configmgr, err)
+	assert.True(test, actual.IsEmpty())
+	_, err = g2configmgr.DiffConfigs(1, 4)
+	assert.ErrorContains(test, err, "Unknown configuration ID 4")
+	_, err = g2configmgr.DiffConfigs(3, 1)
+	assert.ErrorContains(test, err, "not a JSON document")
+	config, err := g2configmgr.GetConfig(ctx, 2)
+	testError(test, ctx, g2configmgr, err)
+	assert.Equal(test, g2configmgr.Configs[2], config)
+}
+
 func TestG2configmgr_Destroy(test *testing.T) {
 	ctx := context.TODO()
 	g2configmgr := getTestObject(ctx, test)
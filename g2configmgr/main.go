@@ -1,8 +1,47 @@
 package g2configmgr
 
+import (
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2configmgrapi "github.com/senzing/g2-sdk-go/g2configmgr"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2configmgr package found messages having the format "senzing-6032xxxx".
 const ProductId = 6032
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Messages for errors reported by the mock, in addition to those of g2configmgrapi.IdMessages.
+var mockIdMessages = map[int]string{
+	4901: "Notification %d could not be delivered to observers: %s",
+	4902: "Unknown configuration ID %d. Set it in Configs.",
+	4903: "Configuration ID %d is not a JSON document.",
+}
+
+// ----------------------------------------------------------------------------
+// Interface assertions
+// ----------------------------------------------------------------------------
+
+// G2configmgr can be used wherever the g2-sdk-go-base or g2-sdk-go-grpc implementation is used.
+var _ g2api.G2configmgr = &G2configmgr{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Combine g2configmgrapi.IdMessages and mockIdMessages.
+func idMessages() map[int]string {
+	result := map[int]string{}
+	for id, message := range g2configmgrapi.IdMessages {
+		result[id] = message
+	}
+	for id, message := range mockIdMessages {
+		result[id] = message
+	}
+	return result
+}
@@ -0,0 +1,93 @@
+package g2configmgr
+
+import (
+	"context"
+
+	"github.com/senzing/g2-sdk-go-mock/resulthelpers"
+	"github.com/senzing/go-logging/messagelogger"
+	"github.com/senzing/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Option configures a G2configmgr created by New().
+type Option func(client *G2configmgr) error
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function creates a G2configmgr configured by options, as an alternative to a struct literal, e.g.
+
+	g2configmgr, err := g2configmgr.New(
+		g2configmgr.WithResults(map[string]interface{}{"GetDefaultConfigIDResult": 4019066234}),
+		g2configmgr.WithObservers(observer),
+	)
+
+Input
+  - options: The options, applied in order.
+
+Output
+  - A G2configmgr.
+  - The error of the first option that failed.
+*/
+func New(options ...Option) (*G2configmgr, error) {
+	client := &G2configmgr{}
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The WithResults function sets "...Result" fields by name with resulthelpers.SetResults().
+
+Input
+  - results: The values of the fields, by field name, e.g. {"GetDefaultConfigIDResult": 4019066234}.
+*/
+func WithResults(results map[string]interface{}) Option {
+	return func(client *G2configmgr) error {
+		return resulthelpers.SetResults(client, results)
+	}
+}
+
+/*
+The WithObservers function registers observers, as RegisterObserver() does.
+
+Input
+  - observers: The observers to notify.
+*/
+func WithObservers(observers ...observer.Observer) Option {
+	return func(client *G2configmgr) error {
+		for _, observer := range observers {
+			if err := client.RegisterObserver(context.Background(), observer); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+/*
+The WithLogger function sets the logger of the G2configmgr, instead of the one created on first use.
+Tracing follows the log level of the logger.
+
+Input
+  - logger: The logger, e.g. one created by isolatedlogger.New().
+*/
+func WithLogger(logger messagelogger.MessageLoggerInterface) Option {
+	return func(client *G2configmgr) error {
+		client.logger = logger
+		client.isTrace.Store(logger.GetLogLevel() == messagelogger.LevelTrace)
+		return nil
+	}
+}
@@ -9,9 +9,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"runtime"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-mock/internal/observers"
+	"github.com/senzing/g2-sdk-go-mock/isolatedlogger"
+	"github.com/senzing/g2-sdk-go-mock/notification"
 	g2diagnosticapi "github.com/senzing/g2-sdk-go/g2diagnostic"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
@@ -24,9 +32,10 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2diagnostic struct {
-	isTrace                        bool
+	isTrace                        atomic.Bool
 	logger                         messagelogger.MessageLoggerInterface
-	observers                      subject.Subject
+	loggerMutex                    sync.Mutex
+	observers                      observers.Registry
 	CheckDBPerfResult              string
 	FetchNextEntityBySizeResult    string
 	FindEntitiesByFeatureIDsResult string
@@ -45,6 +54,19 @@ type G2diagnostic struct {
 	GetRelationshipDetailsResult   string
 	GetResolutionStatisticsResult  string
 	GetTotalSystemMemoryResult     int64
+
+	DatabaseLatency                 time.Duration        // Simulated duration of a single database insert, reported by CheckDBPerf(). Independent of the latencies of a G2engine.
+	UseHostResources                bool                 // Report the host's memory and cores instead of the configured results.
+	NotificationEncoder             notification.Encoder // Encoding of observer notifications. nil is notification.JSON.
+	findEntitiesByFeatureIDsResults map[string]string
+	findEntitiesByFeatureIDsMutex   sync.RWMutex
+
+	Repository Repository // If set, GetDataSourceCounts() and GetEntitySizeBreakdown() summarize its records, e.g. a Stateful G2engine, and FindEntitiesByFeatureIDs() searches their features.
+
+	DestroyError            error // Returned by Destroy(), e.g. to test cleanup after a failed shutdown.
+	UnregisterObserverError error // Returned by UnregisterObserver(), which then keeps the observer registered.
+
+	IsolatedLogger bool // Use a logger whose log level is not changed by messagelogger.SetLogLevel(). See the isolatedlogger package.
 }
 
 // ----------------------------------------------------------------------------
@@ -53,14 +75,30 @@ type G2diagnostic struct {
 
 // Get the Logger singleton.
 func (client *G2diagnostic) getLogger() messagelogger.MessageLoggerInterface {
+	client.loggerMutex.Lock()
+	defer client.loggerMutex.Unlock()
 	if client.logger == nil {
-		client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, g2diagnosticapi.IdMessages, g2diagnosticapi.IdStatuses, messagelogger.LevelInfo)
+		if client.IsolatedLogger {
+			client.logger, _ = isolatedlogger.New(ProductId, idMessages(), g2diagnosticapi.IdStatuses, messagelogger.LevelInfo)
+		} else {
+			client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, idMessages(), g2diagnosticapi.IdStatuses, messagelogger.LevelInfo)
+		}
 	}
 	return client.logger
 }
 
+// Get the registered observers. Returns nil if there are none.
+func (client *G2diagnostic) getObservers() subject.Subject {
+	return client.observers.Subject()
+}
+
 // Notify registered observers.
+// Failures are logged, as notifications are usually sent from goroutines that cannot return them.
 func (client *G2diagnostic) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	observers := client.getObservers()
+	if observers == nil {
+		return
+	}
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
@@ -68,12 +106,33 @@ func (client *G2diagnostic) notify(ctx context.Context, messageId int, err error
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	encode := client.NotificationEncoder
+	if encode == nil {
+		encode = notification.JSON
+	}
+	message, err := encode(details)
+	if err == nil {
+		err = observers.NotifyObservers(ctx, message)
+	}
+	if err != nil {
+		client.getLogger().Log(4901, messageId, err.Error())
+	}
+}
+
+// Normalize a "features" JSON document so that equivalent feature sets share a key.
+// Documents that cannot be parsed are keyed by their raw text.
+func featuresKey(features string) string {
+	parsed := featuresJson{}
+	err := json.Unmarshal([]byte(features), &parsed)
 	if err != nil {
-		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
+		return features
+	}
+	sort.Slice(parsed.LibFeatIDs, func(i, j int) bool { return parsed.LibFeatIDs[i] < parsed.LibFeatIDs[j] })
+	libFeatIDs := make([]string, len(parsed.LibFeatIDs))
+	for i, libFeatID := range parsed.LibFeatIDs {
+		libFeatIDs[i] = strconv.FormatInt(libFeatID, 10)
 	}
+	return fmt.Sprintf("%d:%s", parsed.EntityID, strings.Join(libFeatIDs, ","))
 }
 
 // Trace method entry.
@@ -86,6 +145,44 @@ func (client *G2diagnostic) traceExit(errorNumber int, details ...interface{}) {
 	client.getLogger().Log(errorNumber, details...)
 }
 
+// ----------------------------------------------------------------------------
+// Mock configuration methods
+// ----------------------------------------------------------------------------
+
+/*
+The SetFindEntitiesByFeatureIDsResult method sets the result returned by FindEntitiesByFeatureIDs()
+for a specific "features" JSON document.
+Documents with the same ENTITY_ID and the same set of LIB_FEAT_IDS, in any order, share a result.
+Features without a keyed result are searched in Repository, if it is set, or fall back to FindEntitiesByFeatureIDsResult.
+
+Input
+  - features: A JSON document having the format: `{"ENTITY_ID":<entity id>,"LIB_FEAT_IDS":[<id1>,<id2>,...<idn>]}`.
+  - result: The JSON document to return.
+*/
+func (client *G2diagnostic) SetFindEntitiesByFeatureIDsResult(features string, result string) {
+	client.findEntitiesByFeatureIDsMutex.Lock()
+	defer client.findEntitiesByFeatureIDsMutex.Unlock()
+	if client.findEntitiesByFeatureIDsResults == nil {
+		client.findEntitiesByFeatureIDsResults = map[string]string{}
+	}
+	client.findEntitiesByFeatureIDsResults[featuresKey(features)] = result
+}
+
+// ----------------------------------------------------------------------------
+// Mock inspection methods
+// ----------------------------------------------------------------------------
+
+/*
+The LogLevel method returns the log level of the logger of the G2diagnostic,
+so that tests can check it with isolatedlogger.CheckLevels().
+
+Output
+  - The log level, e.g. logger.LevelInfo.
+*/
+func (client *G2diagnostic) LogLevel() logger.Level {
+	return logger.Level(client.getLogger().GetLogLevel())
+}
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
@@ -101,23 +198,34 @@ Output
 
   - A string containing a JSON document.
     Example: `{"numRecordsInserted":0,"insertTime":0}`
+
+When DatabaseLatency is set, the result is computed from it: "insertTime" is the
+duration of the test in milliseconds and "numRecordsInserted" is the number of
+inserts of DatabaseLatency that fit in that time.
+Otherwise CheckDBPerfResult is returned.
+DatabaseLatency is not derived from the latencies or data source profiles of a G2engine.
 */
 func (client *G2diagnostic) CheckDBPerf(ctx context.Context, secondsToRun int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(1, secondsToRun)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.CheckDBPerfResult
+	if client.DatabaseLatency > 0 {
+		insertTime := time.Duration(secondsToRun) * time.Second
+		result = fmt.Sprintf(`{"numRecordsInserted":%d,"insertTime":%d}`, int64(insertTime/client.DatabaseLatency), insertTime.Milliseconds())
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8001, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(2, secondsToRun, client.CheckDBPerfResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(2, secondsToRun, result, err, time.Since(entryTime))
 	}
-	return client.CheckDBPerfResult, err
+	return result, err
 }
 
 /*
@@ -131,18 +239,18 @@ Input
   - entityListBySizeHandle: A handle created by GetEntityListBySize().
 */
 func (client *G2diagnostic) CloseEntityListBySize(ctx context.Context, entityListBySizeHandle uintptr) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(5)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8002, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(6, err, time.Since(entryTime))
 	}
 	return err
@@ -156,18 +264,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2diagnostic) Destroy(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(7)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.DestroyError
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8003, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(8, err, time.Since(entryTime))
 	}
 	return err
@@ -188,18 +297,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) FetchNextEntityBySize(ctx context.Context, entityListBySizeHandle uintptr) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(9)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8004, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(10, client.FetchNextEntityBySizeResult, err, time.Since(entryTime))
 	}
 	return client.FetchNextEntityBySizeResult, err
@@ -219,21 +328,31 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) FindEntitiesByFeatureIDs(ctx context.Context, features string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(11, features)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	client.findEntitiesByFeatureIDsMutex.RLock()
+	result, ok := client.findEntitiesByFeatureIDsResults[featuresKey(features)]
+	client.findEntitiesByFeatureIDsMutex.RUnlock()
+	if !ok {
+		result = client.FindEntitiesByFeatureIDsResult
+		parsed := featuresJson{}
+		if client.Repository != nil && json.Unmarshal([]byte(features), &parsed) == nil {
+			result = entitiesByFeatureIDs(client.Repository, parsed)
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8005, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(12, features, client.FindEntitiesByFeatureIDsResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(12, features, result, err, time.Since(entryTime))
 	}
-	return client.FindEntitiesByFeatureIDsResult, err
+	return result, err
 }
 
 /*
@@ -244,23 +363,31 @@ Input
 
 Output
   - Number of bytes of available memory.
+
+When UseHostResources is set, the value is read from /proc/meminfo on Linux instead of the configured result.
 */
 func (client *G2diagnostic) GetAvailableMemory(ctx context.Context) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(13)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.GetAvailableMemoryResult
+	if client.UseHostResources {
+		if hostResult, ok := hostAvailableMemory(); ok {
+			result = hostResult
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8006, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(14, client.GetAvailableMemoryResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(14, result, err, time.Since(entryTime))
 	}
-	return client.GetAvailableMemoryResult, err
+	return result, err
 }
 
 /*
@@ -271,24 +398,29 @@ Input
 
 Output
   - A JSON document enumerating data sources.
+    If Repository is set, the data sources of its records.
     See the example output.
 */
 func (client *G2diagnostic) GetDataSourceCounts(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(15)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8007, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(16, client.GetDataSourceCountsResult, err, time.Since(entryTime))
+	result := client.GetDataSourceCountsResult
+	if client.Repository != nil {
+		result = dataSourceCounts(client.Repository)
 	}
-	return client.GetDataSourceCountsResult, err
+	if client.isTrace.Load() {
+		defer client.traceExit(16, result, err, time.Since(entryTime))
+	}
+	return result, err
 }
 
 /*
@@ -302,18 +434,18 @@ Output
     Example: `{"Hybrid Mode":false,"Database Details":[{"Name":"0.0.0.0","Type":"postgresql"}]}`
 */
 func (client *G2diagnostic) GetDBInfo(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(17)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8008, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(18, client.GetDBInfoResult, err, time.Since(entryTime))
 	}
 	return client.GetDBInfoResult, err
@@ -332,18 +464,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetEntityDetails(ctx context.Context, entityID int64, includeInternalFeatures int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(19, entityID, includeInternalFeatures)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8009, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(20, entityID, includeInternalFeatures, client.GetEntityDetailsResult, err, time.Since(entryTime))
 	}
 	return client.GetEntityDetailsResult, err
@@ -363,18 +495,18 @@ Output
   - A handle to an entity list to be used with FetchNextEntityBySize() and CloseEntityListBySize().
 */
 func (client *G2diagnostic) GetEntityListBySize(ctx context.Context, entitySize int) (uintptr, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(21, entitySize)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8010, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(22, entitySize, client.GetEntityListBySizeResult, err, time.Since(entryTime))
 	}
 	return client.GetEntityListBySizeResult, err
@@ -392,18 +524,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetEntityResume(ctx context.Context, entityID int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(23, entityID)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8011, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(24, entityID, client.GetEntityResumeResult, err, time.Since(entryTime))
 	}
 	return client.GetEntityResumeResult, err
@@ -422,21 +554,25 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetEntitySizeBreakdown(ctx context.Context, minimumEntitySize int, includeInternalFeatures int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(25, minimumEntitySize, includeInternalFeatures)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8012, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(26, minimumEntitySize, includeInternalFeatures, client.GetEntitySizeBreakdownResult, err, time.Since(entryTime))
+	result := client.GetEntitySizeBreakdownResult
+	if client.Repository != nil {
+		result = entitySizeBreakdown(client.Repository, minimumEntitySize)
+	}
+	if client.isTrace.Load() {
+		defer client.traceExit(26, minimumEntitySize, includeInternalFeatures, result, err, time.Since(entryTime))
 	}
-	return client.GetEntitySizeBreakdownResult, err
+	return result, err
 }
 
 /*
@@ -451,18 +587,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetFeature(ctx context.Context, libFeatID int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(27, libFeatID)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8013, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(28, libFeatID, client.GetFeatureResult, err, time.Since(entryTime))
 	}
 	return client.GetFeatureResult, err
@@ -481,18 +617,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetGenericFeatures(ctx context.Context, featureType string, maximumEstimatedCount int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(29, featureType, maximumEstimatedCount)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8014, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(30, featureType, maximumEstimatedCount, client.GetGenericFeaturesResult, err, time.Since(entryTime))
 	}
 	return client.GetGenericFeaturesResult, err
@@ -506,23 +642,29 @@ Input
 
 Output
   - Number of logical cores.
+
+When UseHostResources is set, the value is read from the Go runtime instead of the configured result.
 */
 func (client *G2diagnostic) GetLogicalCores(ctx context.Context) (int, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(35)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.GetLogicalCoresResult
+	if client.UseHostResources {
+		result = runtime.NumCPU()
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8015, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(36, client.GetLogicalCoresResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(36, result, err, time.Since(entryTime))
 	}
-	return client.GetLogicalCoresResult, err
+	return result, err
 }
 
 /*
@@ -537,18 +679,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetMappingStatistics(ctx context.Context, includeInternalFeatures int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(37, includeInternalFeatures)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8016, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(38, includeInternalFeatures, client.GetMappingStatisticsResult, err, time.Since(entryTime))
 	}
 	return client.GetMappingStatisticsResult, err
@@ -562,23 +704,31 @@ Input
 
 Output
   - Number of physical cores.
+
+When UseHostResources is set, the value is read from /proc/cpuinfo on Linux instead of the configured result.
 */
 func (client *G2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(39)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.GetPhysicalCoresResult
+	if client.UseHostResources {
+		if hostResult, ok := hostPhysicalCores(); ok {
+			result = hostResult
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8017, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(40, client.GetPhysicalCoresResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(40, result, err, time.Since(entryTime))
 	}
-	return client.GetPhysicalCoresResult, err
+	return result, err
 }
 
 /*
@@ -594,18 +744,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetRelationshipDetails(ctx context.Context, relationshipID int64, includeInternalFeatures int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(41, relationshipID, includeInternalFeatures)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8018, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(42, relationshipID, includeInternalFeatures, client.GetRelationshipDetailsResult, err, time.Since(entryTime))
 	}
 	return client.GetRelationshipDetailsResult, err
@@ -622,18 +772,18 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetResolutionStatistics(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(43)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8019, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(44, client.GetResolutionStatisticsResult, err, time.Since(entryTime))
 	}
 	return client.GetResolutionStatisticsResult, err
@@ -648,18 +798,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2diagnostic) GetSdkId(ctx context.Context) string {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(59)
 	}
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8024, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(60, err, time.Since(entryTime))
 	}
 	return "mock"
@@ -673,23 +823,31 @@ Input
 
 Output
   - Number of bytes of memory.
+
+When UseHostResources is set, the value is read from /proc/meminfo on Linux instead of the configured result.
 */
 func (client *G2diagnostic) GetTotalSystemMemory(ctx context.Context) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(57)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.GetTotalSystemMemoryResult
+	if client.UseHostResources {
+		if hostResult, ok := hostTotalSystemMemory(); ok {
+			result = hostResult
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8020, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(46, client.GetTotalSystemMemoryResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(46, result, err, time.Since(entryTime))
 	}
-	return client.GetTotalSystemMemoryResult, err
+	return result, err
 }
 
 /*
@@ -703,12 +861,12 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2diagnostic) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(47, moduleName, iniParams, verboseLogging)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
@@ -718,7 +876,7 @@ func (client *G2diagnostic) Init(ctx context.Context, moduleName string, iniPara
 			client.notify(ctx, 8021, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(48, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -736,12 +894,12 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2diagnostic) InitWithConfigID(ctx context.Context, moduleName string, iniParams string, initConfigID int64, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(49, moduleName, iniParams, initConfigID, verboseLogging)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
@@ -752,7 +910,7 @@ func (client *G2diagnostic) InitWithConfigID(ctx context.Context, moduleName str
 			client.notify(ctx, 8022, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(50, moduleName, iniParams, initConfigID, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -766,15 +924,12 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2diagnostic) RegisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(55, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
-	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
-	}
-	err := client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
+	err := client.observers.Register(ctx, observer)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"observerID": observer.GetObserverId(ctx),
@@ -782,7 +937,7 @@ func (client *G2diagnostic) RegisterObserver(ctx context.Context, observer obser
 			client.notify(ctx, 8025, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(56, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -796,12 +951,12 @@ Input
   - initConfigID: The configuration ID used for the initialization.
 */
 func (client *G2diagnostic) Reinit(ctx context.Context, initConfigID int64) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(51, initConfigID)
 	}
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"initConfigID": strconv.FormatInt(initConfigID, 10),
@@ -809,7 +964,7 @@ func (client *G2diagnostic) Reinit(ctx context.Context, initConfigID int64) erro
 			client.notify(ctx, 8023, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(52, initConfigID, err, time.Since(entryTime))
 	}
 	return err
@@ -823,14 +978,14 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2diagnostic) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(53, logLevel)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
-	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
-	if client.observers != nil {
+	client.isTrace.Store(client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"logLevel": logger.LevelToTextMap[logLevel],
@@ -838,7 +993,7 @@ func (client *G2diagnostic) SetLogLevel(ctx context.Context, logLevel logger.Lev
 			client.notify(ctx, 8026, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(54, logLevel, err, time.Since(entryTime))
 	}
 	return err
@@ -852,26 +1007,26 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2diagnostic) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(57, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
+	err = client.UnregisterObserverError
+	if client.getObservers() != nil {
 		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
+		// client.notify is called synchronously before the observer is unregistered.
 		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// Then the observer may be unregistered, but observer goroutines will be OK.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8027, err, details)
 	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+	if err == nil {
+		err = client.observers.Unregister(ctx, observer)
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(58, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
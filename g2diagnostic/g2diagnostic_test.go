@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"runtime"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
+	"github.com/senzing/g2-sdk-go-mock/g2engine"
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
 	"github.com/senzing/g2-sdk-go/g2api"
 	"github.com/stretchr/testify/assert"
 )
@@ -122,6 +126,21 @@ func TestG2diagnostic_CheckDBPerf(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2diagnostic_CheckDBPerf_databaseLatency(test *testing.T) {
+	ctx := context.TODO()
+	g2diagnostic := &G2diagnostic{
+		CheckDBPerfResult: `{"numRecordsInserted":76667,"insertTime":1000}`,
+		DatabaseLatency:   4 * time.Millisecond,
+	}
+	actual, err := g2diagnostic.CheckDBPerf(ctx, 2)
+	testError(test, ctx, g2diagnostic, err)
+	assert.Equal(test, `{"numRecordsInserted":500,"insertTime":2000}`, actual)
+	g2diagnostic.DatabaseLatency = 40 * time.Millisecond
+	actual, err = g2diagnostic.CheckDBPerf(ctx, 2)
+	testError(test, ctx, g2diagnostic, err)
+	assert.Equal(test, `{"numRecordsInserted":50,"insertTime":2000}`, actual)
+}
+
 func TestG2diagnostic_EntityListBySize(test *testing.T) {
 	ctx := context.TODO()
 	g2diagnostic := getTestObject(ctx, test)
@@ -144,6 +163,37 @@ func TestG2diagnostic_FindEntitiesByFeatureIDs(test *testing.T) {
 	printResult(test, "len(Actual)", len(actual))
 }
 
+func TestG2diagnostic_FindEntitiesByFeatureIDs_keyed(test *testing.T) {
+	ctx := context.TODO()
+	g2diagnostic := &G2diagnostic{
+		FindEntitiesByFeatureIDsResult: `[]`,
+	}
+	g2diagnostic.SetFindEntitiesByFeatureIDsResult(`{"ENTITY_ID":1,"LIB_FEAT_IDS":[1,3,4]}`, `[{"LIB_FEAT_ID":3,"USAGE_TYPE":"","RES_ENT_ID":2}]`)
+	actual, err := g2diagnostic.FindEntitiesByFeatureIDs(ctx, `{"ENTITY_ID": 1, "LIB_FEAT_IDS": [4, 3, 1]}`)
+	testError(test, ctx, g2diagnostic, err)
+	assert.Equal(test, `[{"LIB_FEAT_ID":3,"USAGE_TYPE":"","RES_ENT_ID":2}]`, actual)
+	actual, err = g2diagnostic.FindEntitiesByFeatureIDs(ctx, `{"ENTITY_ID":2,"LIB_FEAT_IDS":[1,3,4]}`)
+	testError(test, ctx, g2diagnostic, err)
+	assert.Equal(test, `[]`, actual)
+}
+
+func TestG2diagnostic_FindEntitiesByFeatureIDs_repository(test *testing.T) {
+	ctx := context.TODO()
+	engine := &g2engine.G2engine{Stateful: true}
+	g2diagnostic := &G2diagnostic{Repository: engine}
+	err := engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smith","PHONE_NUMBER":"702-555-1212"}`, "")
+	testError(test, ctx, g2diagnostic, err)
+	err = engine.AddRecord(ctx, "WATCHLIST", "2001", `{"NAME_LAST":"Jones","PHONE_NUMBER":"702-555-1212"}`, "")
+	testError(test, ctx, g2diagnostic, err)
+	features, ok := engine.RecordFeatures("CUSTOMERS", "1001")
+	assert.True(test, ok)
+	phoneFeatID := resultbuilder.FeatureID("PHONE", features["PHONE"][0])
+	nameFeatID := resultbuilder.FeatureID("NAME", features["NAME"][0])
+	actual, err := g2diagnostic.FindEntitiesByFeatureIDs(ctx, fmt.Sprintf(`{"ENTITY_ID":1,"LIB_FEAT_IDS":[%d,%d]}`, phoneFeatID, nameFeatID))
+	testError(test, ctx, g2diagnostic, err)
+	assert.Equal(test, fmt.Sprintf(`[{"LIB_FEAT_ID":%d,"USAGE_TYPE":"","RES_ENT_ID":2}]`, phoneFeatID), actual)
+}
+
 func TestG2diagnostic_GetAvailableMemory(test *testing.T) {
 	ctx := context.TODO()
 	g2diagnostic := getTestObject(ctx, test)
@@ -161,6 +211,32 @@ func TestG2diagnostic_GetDataSourceCounts(test *testing.T) {
 	printResult(test, "Data Source counts", actual)
 }
 
+func TestG2diagnostic_GetDataSourceCounts_repository(test *testing.T) {
+	ctx := context.TODO()
+	engine := &g2engine.G2engine{Stateful: true}
+	g2diagnostic := &G2diagnostic{Repository: engine}
+	err := engine.AddRecord(ctx, "WATCHLIST", "2001", `{"NAME_LAST":"Smith"}`, "")
+	testError(test, ctx, g2diagnostic, err)
+	err = engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Jones"}`, "")
+	testError(test, ctx, g2diagnostic, err)
+	err = engine.AddRecord(ctx, "CUSTOMERS", "1002", `{"NAME_LAST":"Brown"}`, "")
+	testError(test, ctx, g2diagnostic, err)
+	actual, err := g2diagnostic.GetDataSourceCounts(ctx)
+	testError(test, ctx, g2diagnostic, err)
+	assert.JSONEq(test, `[{"DSRC_ID":1001,"DSRC_CODE":"CUSTOMERS","ETYPE_ID":3,"ETYPE_CODE":"GENERIC","OBS_ENT_COUNT":2,"DSRC_RECORD_COUNT":2},{"DSRC_ID":1002,"DSRC_CODE":"WATCHLIST","ETYPE_ID":3,"ETYPE_CODE":"GENERIC","OBS_ENT_COUNT":1,"DSRC_RECORD_COUNT":1}]`, actual)
+	actual, err = g2diagnostic.GetEntitySizeBreakdown(ctx, 1, 0)
+	testError(test, ctx, g2diagnostic, err)
+	assert.JSONEq(test, `[{"ENTITY_SIZE":1,"ENTITY_COUNT":3,"MIN_RES_ENT_ID":1,"MAX_RES_ENT_ID":3}]`, actual)
+	err = engine.PurgeRepository(ctx)
+	testError(test, ctx, g2diagnostic, err)
+	actual, err = g2diagnostic.GetDataSourceCounts(ctx)
+	testError(test, ctx, g2diagnostic, err)
+	assert.JSONEq(test, `[]`, actual)
+	actual, err = g2diagnostic.GetEntitySizeBreakdown(ctx, 1, 0)
+	testError(test, ctx, g2diagnostic, err)
+	assert.JSONEq(test, `[]`, actual)
+}
+
 func TestG2diagnostic_GetDBInfo(test *testing.T) {
 	ctx := context.TODO()
 	g2diagnostic := getTestObject(ctx, test)
@@ -271,6 +347,29 @@ func TestG2diagnostic_GetTotalSystemMemory(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2diagnostic_UseHostResources(test *testing.T) {
+	ctx := context.TODO()
+	g2diagnostic := &G2diagnostic{
+		UseHostResources: true,
+	}
+	logicalCores, err := g2diagnostic.GetLogicalCores(ctx)
+	testError(test, ctx, g2diagnostic, err)
+	assert.Equal(test, runtime.NumCPU(), logicalCores)
+	physicalCores, err := g2diagnostic.GetPhysicalCores(ctx)
+	testError(test, ctx, g2diagnostic, err)
+	assert.Greater(test, physicalCores, 0)
+	assert.LessOrEqual(test, physicalCores, logicalCores)
+	if runtime.GOOS == "linux" {
+		totalSystemMemory, err := g2diagnostic.GetTotalSystemMemory(ctx)
+		testError(test, ctx, g2diagnostic, err)
+		assert.Greater(test, totalSystemMemory, int64(0))
+		availableMemory, err := g2diagnostic.GetAvailableMemory(ctx)
+		testError(test, ctx, g2diagnostic, err)
+		assert.Greater(test, availableMemory, int64(0))
+		assert.LessOrEqual(test, availableMemory, totalSystemMemory)
+	}
+}
+
 func TestG2diagnostic_Init(test *testing.T) {
 	ctx := context.TODO()
 	g2diagnostic := &G2diagnostic{}
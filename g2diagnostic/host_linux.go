@@ -0,0 +1,74 @@
+//go:build linux
+
+package g2diagnostic
+
+import (
+	"bufio"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Read a value, in bytes, from /proc/meminfo.
+func hostMeminfo(key string) (int64, bool) {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, false
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == key+":" {
+			kiloBytes, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return 0, false
+			}
+			return kiloBytes * 1024, true
+		}
+	}
+	return 0, false
+}
+
+// Get the memory available on the host, in bytes.
+func hostAvailableMemory() (int64, bool) {
+	return hostMeminfo("MemAvailable")
+}
+
+// Get the total memory of the host, in bytes.
+func hostTotalSystemMemory() (int64, bool) {
+	return hostMeminfo("MemTotal")
+}
+
+// Count the distinct (physical id, core id) pairs in /proc/cpuinfo.
+func hostPhysicalCores() (int, bool) {
+	file, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return runtime.NumCPU(), true
+	}
+	defer file.Close()
+	cores := map[string]bool{}
+	physicalID := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "physical id":
+			physicalID = strings.TrimSpace(value)
+		case "core id":
+			cores[physicalID+"/"+strings.TrimSpace(value)] = true
+		}
+	}
+	if len(cores) == 0 {
+		return runtime.NumCPU(), true
+	}
+	return len(cores), true
+}
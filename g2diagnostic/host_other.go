@@ -0,0 +1,26 @@
+//go:build !linux
+
+package g2diagnostic
+
+import (
+	"runtime"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Host memory is not available on this platform; the configured value is used.
+func hostAvailableMemory() (int64, bool) {
+	return 0, false
+}
+
+// Host memory is not available on this platform; the configured value is used.
+func hostTotalSystemMemory() (int64, bool) {
+	return 0, false
+}
+
+// Without platform support, logical cores are the best available approximation.
+func hostPhysicalCores() (int, bool) {
+	return runtime.NumCPU(), true
+}
@@ -1,8 +1,45 @@
 package g2diagnostic
 
+import (
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2diagnosticapi "github.com/senzing/g2-sdk-go/g2diagnostic"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2diagnostic package found messages having the format "senzing-6033xxxx".
 const ProductId = 6033
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Messages for errors reported by the mock, in addition to those of g2diagnosticapi.IdMessages.
+var mockIdMessages = map[int]string{
+	4901: "Notification %d could not be delivered to observers: %s",
+}
+
+// ----------------------------------------------------------------------------
+// Interface assertions
+// ----------------------------------------------------------------------------
+
+// G2diagnostic can be used wherever the g2-sdk-go-base or g2-sdk-go-grpc implementation is used.
+var _ g2api.G2diagnostic = &G2diagnostic{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Combine g2diagnosticapi.IdMessages and mockIdMessages.
+func idMessages() map[int]string {
+	result := map[int]string{}
+	for id, message := range g2diagnosticapi.IdMessages {
+		result[id] = message
+	}
+	for id, message := range mockIdMessages {
+		result[id] = message
+	}
+	return result
+}
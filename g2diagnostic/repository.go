@@ -0,0 +1,170 @@
+package g2diagnostic
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/senzing/g2-sdk-go-mock/g2engine"
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Repository is the in-memory repository summarized by a G2diagnostic, e.g. a Stateful *g2engine.G2engine.
+type Repository interface {
+	FindStoredRecords(filter map[string]string) []g2engine.StoredRecord
+	RecordFeatures(dataSourceCode string, recordID string) (map[string][]string, bool)
+}
+
+// The "features" document of FindEntitiesByFeatureIDs().
+type featuresJson struct {
+	EntityID   int64   `json:"ENTITY_ID"`
+	LibFeatIDs []int64 `json:"LIB_FEAT_IDS"`
+}
+
+// An element of the document returned by FindEntitiesByFeatureIDs().
+type entityFeatureJson struct {
+	LibFeatID        int64  `json:"LIB_FEAT_ID"`
+	UsageType        string `json:"USAGE_TYPE"`
+	ResolvedEntityID int64  `json:"RES_ENT_ID"`
+}
+
+// An element of the document returned by GetDataSourceCounts().
+type dataSourceCountJson struct {
+	DataSourceID          int64  `json:"DSRC_ID"`
+	DataSourceCode        string `json:"DSRC_CODE"`
+	EntityTypeID          int64  `json:"ETYPE_ID"`
+	EntityTypeCode        string `json:"ETYPE_CODE"`
+	ObservedEntityCount   int    `json:"OBS_ENT_COUNT"`
+	DataSourceRecordCount int    `json:"DSRC_RECORD_COUNT"`
+}
+
+// An element of the document returned by GetEntitySizeBreakdown().
+type entitySizeJson struct {
+	EntitySize        int   `json:"ENTITY_SIZE"`
+	EntityCount       int   `json:"ENTITY_COUNT"`
+	MinResolvedEntity int64 `json:"MIN_RES_ENT_ID"`
+	MaxResolvedEntity int64 `json:"MAX_RES_ENT_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The DSRC_ID of the first data source, in DSRC_CODE order, of a generated GetDataSourceCounts() document.
+const firstDataSourceID = 1001
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Render the GetDataSourceCounts() document of the records of a repository.
+// Data sources are sorted by DSRC_CODE and numbered from firstDataSourceID.
+func dataSourceCounts(repository Repository) string {
+	recordCounts := map[string]int{}
+	entities := map[string]map[int64]bool{}
+	for _, record := range repository.FindStoredRecords(nil) {
+		recordCounts[record.DataSourceCode]++
+		if entities[record.DataSourceCode] == nil {
+			entities[record.DataSourceCode] = map[int64]bool{}
+		}
+		entities[record.DataSourceCode][record.EntityID] = true
+	}
+	dataSourceCodes := make([]string, 0, len(recordCounts))
+	for dataSourceCode := range recordCounts {
+		dataSourceCodes = append(dataSourceCodes, dataSourceCode)
+	}
+	sort.Strings(dataSourceCodes)
+	document := []dataSourceCountJson{}
+	for i, dataSourceCode := range dataSourceCodes {
+		document = append(document, dataSourceCountJson{
+			DataSourceID:          int64(firstDataSourceID + i),
+			DataSourceCode:        dataSourceCode,
+			EntityTypeID:          3,
+			EntityTypeCode:        "GENERIC",
+			ObservedEntityCount:   len(entities[dataSourceCode]),
+			DataSourceRecordCount: recordCounts[dataSourceCode],
+		})
+	}
+	resultBytes, _ := json.Marshal(document)
+	return string(resultBytes)
+}
+
+// Render the GetEntitySizeBreakdown() document of the entities of a repository.
+// The size of an entity is its number of records. Sizes are sorted from largest to smallest.
+func entitySizeBreakdown(repository Repository, minimumEntitySize int) string {
+	entitySizes := map[int64]int{}
+	for _, record := range repository.FindStoredRecords(nil) {
+		entitySizes[record.EntityID]++
+	}
+	bySize := map[int]*entitySizeJson{}
+	for entityID, size := range entitySizes {
+		if size < minimumEntitySize {
+			continue
+		}
+		breakdown, ok := bySize[size]
+		if !ok {
+			breakdown = &entitySizeJson{
+				EntitySize:        size,
+				MinResolvedEntity: entityID,
+				MaxResolvedEntity: entityID,
+			}
+			bySize[size] = breakdown
+		}
+		breakdown.EntityCount++
+		if entityID < breakdown.MinResolvedEntity {
+			breakdown.MinResolvedEntity = entityID
+		}
+		if entityID > breakdown.MaxResolvedEntity {
+			breakdown.MaxResolvedEntity = entityID
+		}
+	}
+	document := []entitySizeJson{}
+	for _, breakdown := range bySize {
+		document = append(document, *breakdown)
+	}
+	sort.Slice(document, func(i, j int) bool {
+		return document[i].EntitySize > document[j].EntitySize
+	})
+	resultBytes, _ := json.Marshal(document)
+	return string(resultBytes)
+}
+
+// Render the FindEntitiesByFeatureIDs() document of the entities of a repository, other than the entity of features,
+// having a record with one of the features, whose FEAT_IDs are made by resultbuilder.FeatureID().
+func entitiesByFeatureIDs(repository Repository, features featuresJson) string {
+	libFeatIDs := map[int64]bool{}
+	for _, libFeatID := range features.LibFeatIDs {
+		libFeatIDs[libFeatID] = true
+	}
+	found := map[entityFeatureJson]bool{}
+	document := []entityFeatureJson{}
+	for _, record := range repository.FindStoredRecords(nil) {
+		if record.EntityID == features.EntityID {
+			continue
+		}
+		recordFeatures, _ := repository.RecordFeatures(record.DataSourceCode, record.RecordID)
+		for featureType, values := range recordFeatures {
+			for _, value := range values {
+				element := entityFeatureJson{
+					LibFeatID:        resultbuilder.FeatureID(featureType, value),
+					ResolvedEntityID: record.EntityID,
+				}
+				if libFeatIDs[element.LibFeatID] && !found[element] {
+					found[element] = true
+					document = append(document, element)
+				}
+			}
+		}
+	}
+	sort.Slice(document, func(i, j int) bool {
+		if document[i].LibFeatID != document[j].LibFeatID {
+			return document[i].LibFeatID < document[j].LibFeatID
+		}
+		return document[i].ResolvedEntityID < document[j].ResolvedEntityID
+	})
+	resultBytes, _ := json.Marshal(document)
+	return string(resultBytes)
+}
@@ -0,0 +1,107 @@
+package g2engine
+
+import (
+	"strconv"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The data sources and entities of a G2engine restricted to roles.
+type accessRules struct {
+	mutex       sync.Mutex
+	dataSources map[string][]string
+	entities    map[int64][]string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Restrict a data source to roles, replacing its previous roles.
+func (rules *accessRules) restrictDataSource(dataSourceCode string, roles []string) {
+	rules.mutex.Lock()
+	defer rules.mutex.Unlock()
+	if rules.dataSources == nil {
+		rules.dataSources = map[string][]string{}
+	}
+	rules.dataSources[dataSourceCode] = append([]string{}, roles...)
+}
+
+// Restrict an entity to roles, replacing its previous roles.
+func (rules *accessRules) restrictEntity(entityID int64, roles []string) {
+	rules.mutex.Lock()
+	defer rules.mutex.Unlock()
+	if rules.entities == nil {
+		rules.entities = map[int64][]string{}
+	}
+	rules.entities[entityID] = append([]string{}, roles...)
+}
+
+// Return the roles allowed to read a data source, reporting whether it is restricted.
+func (rules *accessRules) dataSourceRoles(dataSourceCode string) ([]string, bool) {
+	rules.mutex.Lock()
+	defer rules.mutex.Unlock()
+	roles, ok := rules.dataSources[dataSourceCode]
+	return roles, ok
+}
+
+// Return the roles allowed to read an entity, reporting whether it is restricted.
+func (rules *accessRules) entityRoles(entityID int64) ([]string, bool) {
+	rules.mutex.Lock()
+	defer rules.mutex.Unlock()
+	roles, ok := rules.entities[entityID]
+	return roles, ok
+}
+
+// Return a permission denied error if Role may not read one of the data sources.
+func (client *G2engine) checkDataSourceAccess(methodName string, dataSourceCodes ...string) error {
+	for _, dataSourceCode := range dataSourceCodes {
+		if roles, ok := client.access.dataSourceRoles(dataSourceCode); ok && !hasRole(roles, client.Role) {
+			return client.newError(methodName, map[string]string{"dataSourceCode": dataSourceCode}, 4922, "data source", dataSourceCode, client.Role)
+		}
+	}
+	return nil
+}
+
+// Return a permission denied error if Role may not read one of the entities.
+func (client *G2engine) checkEntityAccess(methodName string, entityIDs ...int64) error {
+	for _, entityID := range entityIDs {
+		if roles, ok := client.access.entityRoles(entityID); ok && !hasRole(roles, client.Role) {
+			return client.newError(methodName, map[string]string{"entityID": strconv.FormatInt(entityID, 10)}, 4922, "entity", strconv.FormatInt(entityID, 10), client.Role)
+		}
+	}
+	return nil
+}
+
+// Return a permission denied error if Role may not read the data source of one of the records
+// or, for a record of the Stateful repository, its entity.
+func (client *G2engine) checkRecordAccess(methodName string, records ...recordKey) error {
+	for _, key := range records {
+		if err := client.checkDataSourceAccess(methodName, key.dataSourceCode); err != nil {
+			return err
+		}
+		if record, ok := client.records().get(key.dataSourceCode, key.recordID); ok {
+			if err := client.checkEntityAccess(methodName, record.entityID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Report whether role is one of roles.
+func hasRole(roles []string, role string) bool {
+	for _, allowed := range roles {
+		if allowed == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The current record ID of an alias, e.g. a legacy record ID.
+type recordAlias struct {
+	recordID   string
+	resolvable bool
+}
+
+// The record ID aliases of a G2engine.
+type recordAliases struct {
+	mutex   sync.Mutex
+	aliases map[recordKey]recordAlias
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Set or replace an alias.
+func (aliases *recordAliases) set(dataSourceCode string, aliasRecordID string, alias recordAlias) {
+	aliases.mutex.Lock()
+	defer aliases.mutex.Unlock()
+	if aliases.aliases == nil {
+		aliases.aliases = map[recordKey]recordAlias{}
+	}
+	aliases.aliases[recordKey{dataSourceCode, aliasRecordID}] = alias
+}
+
+// Return the alias of a record ID, reporting whether it has one.
+func (aliases *recordAliases) get(dataSourceCode string, recordID string) (recordAlias, bool) {
+	aliases.mutex.Lock()
+	defer aliases.mutex.Unlock()
+	alias, ok := aliases.aliases[recordKey{dataSourceCode, recordID}]
+	return alias, ok
+}
+
+// Return the current record ID of a record ID that may be an alias.
+// Aliases that are not resolvable return an unknown record error.
+func (client *G2engine) resolveAlias(methodName string, dataSourceCode string, recordID string) (string, error) {
+	alias, ok := client.aliases.get(dataSourceCode, recordID)
+	if !ok {
+		return recordID, nil
+	}
+	if !alias.resolvable {
+		return recordID, client.newError(methodName, map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4914, dataSourceCode, recordID, alias.recordID)
+	}
+	return alias.recordID, nil
+}
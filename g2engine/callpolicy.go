@@ -0,0 +1,70 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// CallPolicy describes how repeated calls of a method for the same record succeed or fail.
+type CallPolicy int
+
+const (
+	// SucceedThenFail makes the first call succeed and every retry fail with a duplicate error.
+	SucceedThenFail CallPolicy = iota + 1
+
+	// FailThenSucceed makes the first call fail with a duplicate error and every retry succeed.
+	FailThenSucceed
+)
+
+// Identifies the calls of a method for one record.
+type callPolicyKey struct {
+	methodName string
+	record     recordKey
+}
+
+// The call policies of a G2engine and the number of calls made under each.
+type callPolicies struct {
+	mutex    sync.Mutex
+	policies map[callPolicyKey]CallPolicy
+	calls    map[callPolicyKey]int
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Set the policy of a method for a record and restart its call count.
+func (policies *callPolicies) set(methodName string, dataSourceCode string, recordID string, policy CallPolicy) {
+	policies.mutex.Lock()
+	defer policies.mutex.Unlock()
+	if policies.policies == nil {
+		policies.policies = map[callPolicyKey]CallPolicy{}
+		policies.calls = map[callPolicyKey]int{}
+	}
+	key := callPolicyKey{methodName: methodName, record: recordKey{dataSourceCode: dataSourceCode, recordID: recordID}}
+	policies.policies[key] = policy
+	delete(policies.calls, key)
+}
+
+// Count a call of a method for a record. Returns true if the call should fail.
+func (policies *callPolicies) fail(methodName string, dataSourceCode string, recordID string) bool {
+	policies.mutex.Lock()
+	defer policies.mutex.Unlock()
+	key := callPolicyKey{methodName: methodName, record: recordKey{dataSourceCode: dataSourceCode, recordID: recordID}}
+	policy, ok := policies.policies[key]
+	if !ok {
+		return false
+	}
+	policies.calls[key]++
+	isFirst := policies.calls[key] == 1
+	switch policy {
+	case SucceedThenFail:
+		return !isFirst
+	case FailThenSucceed:
+		return isFirst
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package g2engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Cluster is the state shared by G2engine instances emulating the nodes of one Senzing deployment.
+Records written to the Stateful repository through one member are visible through the others.
+Each member may set its own ConfigCompatibilityVersion and OmitResponseKeys,
+and be paired with a g2product.G2product reporting its own VersionResult,
+to emulate a cluster in the middle of a rolling upgrade.
+*/
+type Cluster struct {
+	store recordStore
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the in-memory repository: the Cluster's, if set.
+func (client *G2engine) records() *recordStore {
+	if client.Cluster != nil {
+		return &client.Cluster.store
+	}
+	return &client.store
+}
+
+// Remove OmitResponseKeys from a JSON response. Other responses are returned unchanged.
+func (client *G2engine) omitResponseKeys(response string) string {
+	if len(client.OmitResponseKeys) == 0 {
+		return response
+	}
+	decoder := json.NewDecoder(strings.NewReader(response))
+	decoder.UseNumber()
+	var document interface{}
+	if decoder.Decode(&document) != nil {
+		return response
+	}
+	omitted := map[string]bool{}
+	for _, key := range client.OmitResponseKeys {
+		omitted[key] = true
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if encoder.Encode(omitKeys(document, omitted)) != nil {
+		return response
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Remove keys from the objects of a decoded JSON document, at any depth.
+func omitKeys(value interface{}, omitted map[string]bool) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, element := range typed {
+			if omitted[key] {
+				delete(typed, key)
+				continue
+			}
+			typed[key] = omitKeys(element, omitted)
+		}
+	case []interface{}:
+		for i, element := range typed {
+			typed[i] = omitKeys(element, omitted)
+		}
+	}
+	return value
+}
@@ -0,0 +1,54 @@
+package g2engine
+
+import (
+	"encoding/json"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The part of a configuration document holding its compatibility version.
+type configVersionJson struct {
+	G2Config struct {
+		ConfigBaseVersion struct {
+			CompatibilityVersion struct {
+				ConfigVersion string `json:"CONFIG_VERSION"`
+			} `json:"COMPATIBILITY_VERSION"`
+		} `json:"CONFIG_BASE_VERSION"`
+	} `json:"G2_CONFIG"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the COMPATIBILITY_VERSION of a configuration document, or "" if it has none.
+func configCompatibilityVersion(config string) string {
+	parsed := configVersionJson{}
+	if err := json.Unmarshal([]byte(config), &parsed); err != nil {
+		return ""
+	}
+	return parsed.G2Config.ConfigBaseVersion.CompatibilityVersion.ConfigVersion
+}
+
+// Return the configuration selected by a configuration ID.
+// Configurations not in Configs are assumed to be ExportConfigResult.
+func (client *G2engine) selectedConfig(configID int64) string {
+	if config, ok := client.Configs[configID]; ok {
+		return config
+	}
+	return client.stringResult(&client.ExportConfigResult)
+}
+
+// Return an error if ConfigCompatibilityVersion is set and the configuration has a different version.
+func (client *G2engine) checkConfigCompatibility(methodName string, config string) error {
+	if len(client.ConfigCompatibilityVersion) == 0 {
+		return nil
+	}
+	version := configCompatibilityVersion(config)
+	if version != client.ConfigCompatibilityVersion {
+		return client.newError(methodName, nil, 4908, methodName, version, client.ConfigCompatibilityVersion)
+	}
+	return nil
+}
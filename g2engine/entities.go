@@ -0,0 +1,53 @@
+package g2engine
+
+import (
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+/*
+Build the document returned by the GetEntityBy...() methods for an entity of the Stateful repository.
+RECORDS lists the records of the entity, each first seen when it was added and last seen when it was last written,
+so RECORD_SUMMARY reports the record count and first and last seen times of each data source.
+RELATED_ENTITIES lists its relationships set with AddPathEdge().
+With EntityPageSize, RECORDS holds the first page of records and RECORDS_PAGE the continuation marker of the next.
+Returns false if the entity has no records.
+*/
+func (client *G2engine) storedEntity(entityID int64) (string, bool) {
+	records := client.records().entityRecords(entityID)
+	if len(records) == 0 {
+		return "", false
+	}
+	result := resultbuilder.NewEntityDoc().EntityID(entityID).PageSize(client.EntityPageSize)
+	lastSeen := records[0].updateTime
+	for _, record := range records {
+		if record.updateTime.After(lastSeen) {
+			lastSeen = record.updateTime
+		}
+		if names := deriveFeatures(record.jsonData)["NAME"]; len(names) > 0 && len(result.ResolvedEntity().EntityName) == 0 {
+			result.Name(names[0])
+		}
+	}
+	result.LastSeen(lastSeen)
+	for _, record := range records {
+		result.AddRecord(resultbuilder.Record{
+			DataSource:  record.dataSourceCode,
+			RecordID:    record.recordID,
+			FirstSeenDt: resultbuilder.FormatTimestamp(record.insertTime),
+			LastSeenDt:  resultbuilder.FormatTimestamp(record.updateTime),
+		})
+	}
+	return client.paths.addRelated(result, entityID).JSON(), true
+}
+
+// Build the document returned by the GetEntityBy...() methods for the entity of a record of the Stateful repository.
+func (client *G2engine) storedEntityByRecordID(dataSourceCode string, recordID string) (string, bool) {
+	record, ok := client.records().get(dataSourceCode, recordID)
+	if !ok {
+		return "", false
+	}
+	return client.storedEntity(record.entityID)
+}
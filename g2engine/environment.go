@@ -0,0 +1,109 @@
+package g2engine
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/senzing/go-logging/logger"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A data source profile in EnvChaosProfile.
+type chaosProfileJson struct {
+	Latency                   string  `json:"LATENCY,omitempty"`
+	ErrorRate                 float64 `json:"ERROR_RATE,omitempty"`
+	WithInfoTemplate          string  `json:"WITH_INFO_TEMPLATE,omitempty"`
+	InterestingEntitiesResult string  `json:"INTERESTING_ENTITIES_RESULT,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Apply the Env... environment variables that are set.
+// They only fill in configuration that has not been set in code.
+func (client *G2engine) configureFromEnvironment(ctx context.Context, methodName string) error {
+	if value, ok := os.LookupEnv(EnvLogLevel); ok {
+		level, ok := logger.TextToLevelMap[strings.ToUpper(value)]
+		if !ok {
+			return client.newError(methodName, nil, 4904, EnvLogLevel, value)
+		}
+		if err := client.SetLogLevel(ctx, level); err != nil {
+			return err
+		}
+	}
+	if value, ok := os.LookupEnv(EnvCallLatency); ok && client.CallLatency == 0 {
+		latency, err := time.ParseDuration(value)
+		if err != nil {
+			return client.newError(methodName, nil, 4904, EnvCallLatency, value)
+		}
+		client.CallLatency = latency
+	}
+	if value, ok := os.LookupEnv(EnvChaosProfile); ok {
+		if err := client.configureChaosProfile(value); err != nil {
+			return client.newError(methodName, nil, 4904, EnvChaosProfile, value)
+		}
+	}
+	if value, ok := os.LookupEnv(EnvFixtureDir); ok {
+		if err := client.configureFixtureDir(value); err != nil {
+			return client.newError(methodName, nil, 4904, EnvFixtureDir, err.Error())
+		}
+	}
+	return nil
+}
+
+// Set the data source profiles of a chaos profile document, except for data sources that have a profile.
+func (client *G2engine) configureChaosProfile(document string) error {
+	chaosProfile := map[string]chaosProfileJson{}
+	if err := json.Unmarshal([]byte(document), &chaosProfile); err != nil {
+		return err
+	}
+	profiles := map[string]DataSourceProfile{}
+	for dataSourceCode, profile := range chaosProfile {
+		dataSourceProfile, err := profile.dataSourceProfile()
+		if err != nil {
+			return err
+		}
+		profiles[dataSourceCode] = dataSourceProfile
+	}
+	for dataSourceCode, profile := range profiles {
+		if _, ok := client.dataSourceProfile(dataSourceCode); !ok {
+			client.SetDataSourceProfile(dataSourceCode, profile)
+		}
+	}
+	return nil
+}
+
+// Set the empty "...Result" fields that have a file in the directory, e.g. "GetRecordResult.json".
+func (client *G2engine) configureFixtureDir(directory string) error {
+	if _, err := os.Stat(directory); err != nil {
+		return err
+	}
+	client.resultsMutex.Lock()
+	defer client.resultsMutex.Unlock()
+	value := reflect.ValueOf(client).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		name := value.Type().Field(i).Name
+		field := value.Field(i)
+		if !strings.HasSuffix(name, "Result") || field.Kind() != reflect.String || field.Len() > 0 {
+			continue
+		}
+		fixture, err := os.ReadFile(filepath.Join(directory, name+".json"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		field.SetString(strings.TrimSpace(string(fixture)))
+	}
+	return nil
+}
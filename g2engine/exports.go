@@ -0,0 +1,110 @@
+package g2engine
+
+import (
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The exports started while ExportPages is set, by handle.
+type exportCursors struct {
+	mutex      sync.Mutex
+	lastHandle uintptr
+	open       map[uintptr]*exportCursor
+	closed     map[uintptr]bool
+}
+
+// The pages of an export and the position of the next page to fetch.
+type exportCursor struct {
+	pages   []string
+	next    int
+	started time.Time
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Start an export of a copy of pages at a time, returning its unique handle.
+func (cursors *exportCursors) start(pages []string, started time.Time) uintptr {
+	cursors.mutex.Lock()
+	defer cursors.mutex.Unlock()
+	if cursors.open == nil {
+		cursors.open = map[uintptr]*exportCursor{}
+	}
+	cursors.lastHandle++
+	cursors.open[cursors.lastHandle] = &exportCursor{
+		pages:   append([]string{}, pages...),
+		started: started,
+	}
+	return cursors.lastHandle
+}
+
+// Return the next page of an export, or an empty string after the last page.
+// Reports whether the handle is an export started by start().
+func (cursors *exportCursors) fetch(handle uintptr) (string, bool) {
+	cursors.mutex.Lock()
+	defer cursors.mutex.Unlock()
+	cursor, ok := cursors.open[handle]
+	if !ok {
+		return "", false
+	}
+	if cursor.next >= len(cursor.pages) {
+		return "", true
+	}
+	cursor.next++
+	return cursor.pages[cursor.next-1], true
+}
+
+// End an export. Its handle stays closed.
+func (cursors *exportCursors) close(handle uintptr) {
+	cursors.mutex.Lock()
+	defer cursors.mutex.Unlock()
+	if _, ok := cursors.open[handle]; !ok {
+		return
+	}
+	delete(cursors.open, handle)
+	if cursors.closed == nil {
+		cursors.closed = map[uintptr]bool{}
+	}
+	cursors.closed[handle] = true
+}
+
+// Return the progress of an export at now, estimating its completion from the pages fetched so far.
+// Reports whether the handle is an export started by start().
+func (cursors *exportCursors) progress(handle uintptr, now time.Time) (Progress, bool) {
+	cursors.mutex.Lock()
+	defer cursors.mutex.Unlock()
+	cursor, ok := cursors.open[handle]
+	if !ok {
+		return Progress{}, false
+	}
+	total := len(cursor.pages)
+	if cursor.next >= total {
+		return Progress{PercentComplete: 100, Done: true}, true
+	}
+	result := Progress{PercentComplete: 100 * cursor.next / total}
+	if cursor.next > 0 {
+		perPage := now.Sub(cursor.started) / time.Duration(cursor.next)
+		result.ETA = now.Add(perPage * time.Duration(total-cursor.next))
+	}
+	return result, true
+}
+
+// Report whether an export handle has been closed.
+func (cursors *exportCursors) isClosed(handle uintptr) bool {
+	cursors.mutex.Lock()
+	defer cursors.mutex.Unlock()
+	return cursors.closed[handle]
+}
+
+// Return the handle of a new export: a unique handle bound to ExportPages if it is set, else result.
+func (client *G2engine) startExport(result uintptr) uintptr {
+	if client.ExportPages == nil {
+		return result
+	}
+	return client.exports.start(client.ExportPages, client.now())
+}
@@ -0,0 +1,55 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// FaultSchedule describes which calls of a method fail, counting calls from when it is scheduled.
+type FaultSchedule struct {
+	Call     int   // The first call that fails, from 1, e.g. 100 to fail the 100th call.
+	Failures int   // The number of calls that fail from Call on. 0 fails every call from Call on, without recovering.
+	Err      error // Returned by the failing calls. nil returns a "scheduled failure" error.
+}
+
+// The fault schedules of a G2engine and the number of calls made under each, by method.
+type faultSchedules struct {
+	mutex     sync.Mutex
+	schedules map[string]FaultSchedule
+	calls     map[string]int
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Set the schedule of a method and restart its call count.
+func (schedules *faultSchedules) set(methodName string, schedule FaultSchedule) {
+	schedules.mutex.Lock()
+	defer schedules.mutex.Unlock()
+	if schedules.schedules == nil {
+		schedules.schedules = map[string]FaultSchedule{}
+		schedules.calls = map[string]int{}
+	}
+	schedules.schedules[methodName] = schedule
+	delete(schedules.calls, methodName)
+}
+
+// Count a call of a method. Returns the number of the call and true if it should fail.
+func (schedules *faultSchedules) fail(methodName string) (FaultSchedule, int, bool) {
+	schedules.mutex.Lock()
+	defer schedules.mutex.Unlock()
+	schedule, ok := schedules.schedules[methodName]
+	if !ok {
+		return schedule, 0, false
+	}
+	schedules.calls[methodName]++
+	call := schedules.calls[methodName]
+	if call < schedule.Call {
+		return schedule, call, false
+	}
+	return schedule, call, schedule.Failures == 0 || call < schedule.Call+schedule.Failures
+}
@@ -0,0 +1,87 @@
+package g2engine
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A feature added or removed by ReplaceRecordWithInfo() when FeatureChanges is set.
+type featureChangeJson struct {
+	FeatType string `json:"FEAT_TYPE"`
+	FeatDesc string `json:"FEAT_DESC"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add the features changed by replacing a record of the Stateful repository to a "...WithInfo" document.
+// An empty document is replaced by one affecting the record's entity. Other documents that are not JSON objects are returned unchanged.
+func (client *G2engine) withFeatureChanges(result string, previous storedRecord, dataSourceCode string, recordID string) string {
+	current, ok := client.records().get(dataSourceCode, recordID)
+	if !ok {
+		return result
+	}
+	if len(result) == 0 {
+		generated, err := json.Marshal(withInfoJson{
+			DataSource:       dataSourceCode,
+			RecordID:         recordID,
+			AffectedEntities: []withInfoEntity{{EntityID: current.entityID}},
+			InterestingEntities: withInfoInteresting{
+				Entities: []withInfoEntity{},
+			},
+		})
+		if err != nil {
+			return result
+		}
+		result = string(generated)
+	}
+	document := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(result), &document); err != nil {
+		return result
+	}
+	document["REPLACE_COUNT"] = current.replaceCount
+	document["ADDED_FEATURES"] = featureDifference(current.jsonData, previous.jsonData)
+	document["REMOVED_FEATURES"] = featureDifference(previous.jsonData, current.jsonData)
+	changed, err := json.Marshal(document)
+	if err != nil {
+		return result
+	}
+	return client.canonical(string(changed))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the features derived from one record's JSON data that are not derived from the other's,
+// ordered by feature type and description. Candidate keys, e.g. NAME_KEY, are not included.
+func featureDifference(jsonData string, otherJsonData string) []featureChangeJson {
+	other := deriveFeatures(otherJsonData)
+	result := []featureChangeJson{}
+	for featureType, values := range deriveFeatures(jsonData) {
+		if strings.HasSuffix(featureType, "_KEY") {
+			continue
+		}
+		for _, value := range values {
+			// Values of each feature type are sorted.
+			index := sort.SearchStrings(other[featureType], value)
+			if index < len(other[featureType]) && other[featureType][index] == value {
+				continue
+			}
+			result = append(result, featureChangeJson{FeatType: featureType, FeatDesc: value})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].FeatType != result[j].FeatType {
+			return result[i].FeatType < result[j].FeatType
+		}
+		return result[i].FeatDesc < result[j].FeatDesc
+	})
+	return result
+}
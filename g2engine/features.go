@@ -0,0 +1,145 @@
+package g2engine
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Record attributes, by suffix, that make up each name and address.
+var (
+	nameAttributes    = []string{"NAME_FULL", "NAME_ORG", "NAME_FIRST", "NAME_MIDDLE", "NAME_LAST"}
+	addressAttributes = []string{"ADDR_FULL", "ADDR_LINE1", "ADDR_LINE2", "ADDR_CITY", "ADDR_STATE", "ADDR_POSTAL_CODE", "ADDR_COUNTRY"}
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the value of the first attribute having the suffix, or "".
+func attributeBySuffix(attributes map[string]string, suffix string) string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == suffix || strings.HasSuffix(key, "_"+suffix) {
+			return attributes[key]
+		}
+	}
+	return ""
+}
+
+// Join the values of the attributes having the suffixes, in suffix order.
+func joinAttributes(attributes map[string]string, suffixes []string) string {
+	values := []string{}
+	for _, suffix := range suffixes {
+		if value := strings.TrimSpace(attributeBySuffix(attributes, suffix)); len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+// Return the upper-case words of a value, sorted and joined with "|".
+func tokenKey(value string) string {
+	tokens := strings.FieldsFunc(strings.ToUpper(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	sort.Strings(tokens)
+	return strings.Join(tokens, "|")
+}
+
+// Return the last ten digits of a phone number.
+func phoneKey(value string) string {
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, value)
+	if len(digits) > 10 {
+		digits = digits[len(digits)-10:]
+	}
+	return digits
+}
+
+// Split a record into groups of string attributes:
+// the top-level attributes, and each object of each top-level list.
+func attributeGroups(jsonData string) []map[string]string {
+	parsed := map[string]interface{}{}
+	if json.Unmarshal([]byte(jsonData), &parsed) != nil {
+		return nil
+	}
+	topLevel := map[string]string{}
+	result := []map[string]string{topLevel}
+	for key, value := range parsed {
+		switch typed := value.(type) {
+		case string:
+			topLevel[key] = typed
+		case []interface{}:
+			for _, element := range typed {
+				object, ok := element.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				group := map[string]string{}
+				for elementKey, elementValue := range object {
+					if text, ok := elementValue.(string); ok {
+						group[elementKey] = text
+					}
+				}
+				result = append(result, group)
+			}
+		}
+	}
+	return result
+}
+
+/*
+Derive the features of a record from its JSON data, by feature type.
+Supported feature types are NAME, ADDRESS, PHONE, EMAIL and DOB, and the
+NAME_KEY, ADDR_KEY, PHONE_KEY and EMAIL_KEY values used as candidate keys.
+Values of each feature type are unique and sorted.
+*/
+func deriveFeatures(jsonData string) map[string][]string {
+	features := map[string]map[string]bool{}
+	add := func(featureType string, value string) {
+		if len(value) == 0 {
+			return
+		}
+		if features[featureType] == nil {
+			features[featureType] = map[string]bool{}
+		}
+		features[featureType][value] = true
+	}
+	for _, attributes := range attributeGroups(jsonData) {
+		name := joinAttributes(attributes, nameAttributes)
+		add("NAME", name)
+		add("NAME_KEY", tokenKey(name))
+		address := joinAttributes(attributes, addressAttributes)
+		add("ADDRESS", address)
+		add("ADDR_KEY", tokenKey(address))
+		phone := attributeBySuffix(attributes, "PHONE_NUMBER")
+		add("PHONE", phone)
+		add("PHONE_KEY", phoneKey(phone))
+		email := attributeBySuffix(attributes, "EMAIL_ADDRESS")
+		add("EMAIL", email)
+		add("EMAIL_KEY", strings.ToLower(strings.TrimSpace(email)))
+		add("DOB", attributeBySuffix(attributes, "DATE_OF_BIRTH"))
+	}
+	result := map[string][]string{}
+	for featureType, values := range features {
+		for value := range values {
+			result[featureType] = append(result[featureType], value)
+		}
+		sort.Strings(result[featureType])
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package g2engine
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// FixtureSet is a named set of results, by the name of their "...Result" field, e.g. "GetRecordResult".
+type FixtureSet map[string]string
+
+// The fixture sets registered with RegisterFixtureSet() and the active one.
+type fixtureSets struct {
+	mutex    sync.Mutex
+	sets     map[string]FixtureSet
+	active   string
+	baseline map[string]string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Register a copy of a fixture set.
+func (fixtures *fixtureSets) register(name string, fixtureSet FixtureSet) {
+	fixtures.mutex.Lock()
+	defer fixtures.mutex.Unlock()
+	if fixtures.sets == nil {
+		fixtures.sets = map[string]FixtureSet{}
+	}
+	copied := FixtureSet{}
+	for fieldName, result := range fixtureSet {
+		copied[fieldName] = result
+	}
+	fixtures.sets[name] = copied
+}
+
+// Set the "...Result" fields of a G2engine to a registered fixture set.
+// The fields are first restored to the values they had before the first fixture set was used,
+// so fields not in the set do not keep the results of the previous set.
+func (client *G2engine) useFixtureSet(name string) error {
+	fixtures := &client.fixtureSets
+	fixtures.mutex.Lock()
+	defer fixtures.mutex.Unlock()
+	fixtureSet, ok := fixtures.sets[name]
+	if !ok {
+		return client.newError("UseFixtureSet", map[string]string{"name": name}, 4918, name)
+	}
+	value := reflect.ValueOf(client).Elem()
+	for fieldName := range fixtureSet {
+		if !isResultField(value, fieldName) {
+			return client.newError("UseFixtureSet", map[string]string{"name": name}, 4919, name, fieldName)
+		}
+	}
+	client.resultsMutex.Lock()
+	defer client.resultsMutex.Unlock()
+	if fixtures.baseline == nil {
+		fixtures.baseline = map[string]string{}
+		for i := 0; i < value.NumField(); i++ {
+			fieldName := value.Type().Field(i).Name
+			if isResultField(value, fieldName) {
+				fixtures.baseline[fieldName] = value.Field(i).String()
+			}
+		}
+	}
+	for fieldName, result := range fixtures.baseline {
+		value.FieldByName(fieldName).SetString(result)
+	}
+	for fieldName, result := range fixtureSet {
+		value.FieldByName(fieldName).SetString(result)
+	}
+	fixtures.active = name
+	return nil
+}
+
+// Return the name of the fixture set in use, or an empty string.
+func (fixtures *fixtureSets) getActive() string {
+	fixtures.mutex.Lock()
+	defer fixtures.mutex.Unlock()
+	return fixtures.active
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Report whether a G2engine has an exported string field of the name ending in "Result".
+func isResultField(value reflect.Value, fieldName string) bool {
+	field, ok := value.Type().FieldByName(fieldName)
+	return ok && field.IsExported() && strings.HasSuffix(fieldName, "Result") && field.Type.Kind() == reflect.String
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ReadFixtureSet function reads a fixture set from a directory with one file per "...Result" field,
+e.g. "GetRecordResult.json", the layout of the EnvFixtureDir directory.
+Files not named after a "...Result" field are ignored.
+
+Input
+  - directory: The directory of the fixture set, e.g. "testdata/merge-heavy".
+
+Output
+  - The results of the files, without leading and trailing white space.
+  - An error if the directory cannot be read.
+*/
+func ReadFixtureSet(directory string) (FixtureSet, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	value := reflect.ValueOf(&G2engine{}).Elem()
+	result := FixtureSet{}
+	for _, entry := range entries {
+		fieldName := strings.TrimSuffix(entry.Name(), ".json")
+		if entry.IsDir() || fieldName == entry.Name() || !isResultField(value, fieldName) {
+			continue
+		}
+		fixture, err := os.ReadFile(filepath.Join(directory, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result[fieldName] = strings.TrimSpace(string(fixture))
+	}
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package g2engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Names of the single-bit G2 flags, in bit order.
+var flagNames = []struct {
+	flag g2api.FlagMask
+	name string
+}{
+	{g2api.G2_EXPORT_INCLUDE_POSSIBLY_SAME, "G2_EXPORT_INCLUDE_POSSIBLY_SAME"},
+	{g2api.G2_EXPORT_INCLUDE_POSSIBLY_RELATED, "G2_EXPORT_INCLUDE_POSSIBLY_RELATED"},
+	{g2api.G2_EXPORT_INCLUDE_NAME_ONLY, "G2_EXPORT_INCLUDE_NAME_ONLY"},
+	{g2api.G2_EXPORT_INCLUDE_DISCLOSED, "G2_EXPORT_INCLUDE_DISCLOSED"},
+	{g2api.G2_EXPORT_INCLUDE_SINGLETONS, "G2_EXPORT_INCLUDE_SINGLETONS"},
+	{g2api.G2_ENTITY_INCLUDE_POSSIBLY_SAME_RELATIONS, "G2_ENTITY_INCLUDE_POSSIBLY_SAME_RELATIONS"},
+	{g2api.G2_ENTITY_INCLUDE_POSSIBLY_RELATED_RELATIONS, "G2_ENTITY_INCLUDE_POSSIBLY_RELATED_RELATIONS"},
+	{g2api.G2_ENTITY_INCLUDE_NAME_ONLY_RELATIONS, "G2_ENTITY_INCLUDE_NAME_ONLY_RELATIONS"},
+	{g2api.G2_ENTITY_INCLUDE_DISCLOSED_RELATIONS, "G2_ENTITY_INCLUDE_DISCLOSED_RELATIONS"},
+	{g2api.G2_ENTITY_INCLUDE_ALL_FEATURES, "G2_ENTITY_INCLUDE_ALL_FEATURES"},
+	{g2api.G2_ENTITY_INCLUDE_REPRESENTATIVE_FEATURES, "G2_ENTITY_INCLUDE_REPRESENTATIVE_FEATURES"},
+	{g2api.G2_ENTITY_INCLUDE_ENTITY_NAME, "G2_ENTITY_INCLUDE_ENTITY_NAME"},
+	{g2api.G2_ENTITY_INCLUDE_RECORD_SUMMARY, "G2_ENTITY_INCLUDE_RECORD_SUMMARY"},
+	{g2api.G2_ENTITY_INCLUDE_RECORD_DATA, "G2_ENTITY_INCLUDE_RECORD_DATA"},
+	{g2api.G2_ENTITY_INCLUDE_RECORD_MATCHING_INFO, "G2_ENTITY_INCLUDE_RECORD_MATCHING_INFO"},
+	{g2api.G2_ENTITY_INCLUDE_RECORD_JSON_DATA, "G2_ENTITY_INCLUDE_RECORD_JSON_DATA"},
+	{g2api.G2_ENTITY_INCLUDE_RECORD_FORMATTED_DATA, "G2_ENTITY_INCLUDE_RECORD_FORMATTED_DATA"},
+	{g2api.G2_ENTITY_INCLUDE_RECORD_FEATURE_IDS, "G2_ENTITY_INCLUDE_RECORD_FEATURE_IDS"},
+	{g2api.G2_ENTITY_INCLUDE_RELATED_ENTITY_NAME, "G2_ENTITY_INCLUDE_RELATED_ENTITY_NAME"},
+	{g2api.G2_ENTITY_INCLUDE_RELATED_MATCHING_INFO, "G2_ENTITY_INCLUDE_RELATED_MATCHING_INFO"},
+	{g2api.G2_ENTITY_INCLUDE_RELATED_RECORD_SUMMARY, "G2_ENTITY_INCLUDE_RELATED_RECORD_SUMMARY"},
+	{g2api.G2_ENTITY_INCLUDE_RELATED_RECORD_DATA, "G2_ENTITY_INCLUDE_RELATED_RECORD_DATA"},
+	{g2api.G2_ENTITY_OPTION_INCLUDE_INTERNAL_FEATURES, "G2_ENTITY_OPTION_INCLUDE_INTERNAL_FEATURES"},
+	{g2api.G2_ENTITY_OPTION_INCLUDE_FEATURE_STATS, "G2_ENTITY_OPTION_INCLUDE_FEATURE_STATS"},
+	{g2api.G2_FIND_PATH_PREFER_EXCLUDE, "G2_FIND_PATH_PREFER_EXCLUDE"},
+	{g2api.G2_INCLUDE_FEATURE_SCORES, "G2_INCLUDE_FEATURE_SCORES"},
+	{g2api.G2_SEARCH_INCLUDE_STATS, "G2_SEARCH_INCLUDE_STATS"},
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return an error if StrictFlags is set and the flags have bits that are not known G2 flags,
+// or not allowed for the method by SetAllowedFlags().
+func (client *G2engine) checkFlags(methodName string, flags int64) error {
+	if !client.StrictFlags {
+		return nil
+	}
+	allowed := knownFlags()
+	client.flagsMutex.Lock()
+	if methodAllowed, ok := client.allowedFlags[methodName]; ok {
+		allowed &= methodAllowed
+	}
+	client.flagsMutex.Unlock()
+	if disallowed := flags &^ allowed; disallowed != 0 {
+		return client.newError(methodName, map[string]string{"flags": strconv.FormatInt(flags, 10)}, 4915, flags, methodName, describeFlags(disallowed))
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the bits of the known single-bit G2 flags.
+func knownFlags() int64 {
+	var result int64 = 0
+	for _, flagName := range flagNames {
+		result |= int64(flagName.flag)
+	}
+	return result
+}
+
+// Describe flags by the names of the known flags set, followed by the remaining bits in hexadecimal.
+func describeFlags(flags int64) string {
+	names := DecodeFlags(flags)
+	if unknown := flags &^ knownFlags(); unknown != 0 {
+		names = append(names, fmt.Sprintf("0x%x", unknown))
+	}
+	return strings.Join(names, ", ")
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The DecodeFlags function returns the names of the single-bit G2 flags set in a flags value,
+so tests can assert on named flags rather than comparing int64 values.
+Bits that do not correspond to a known flag are ignored.
+
+Input
+  - flags: A flags value, e.g. from FlagsUsed().
+
+Output
+  - The names of the flags that are set, in bit order.
+*/
+func DecodeFlags(flags int64) []string {
+	result := []string{}
+	for _, flagName := range flagNames {
+		if flags&int64(flagName.flag) != 0 {
+			result = append(result, flagName.name)
+		}
+	}
+	return result
+}
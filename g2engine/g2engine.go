@@ -9,9 +9,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-mock/iniparams"
+	"github.com/senzing/g2-sdk-go-mock/internal/observers"
+	"github.com/senzing/g2-sdk-go-mock/isolatedlogger"
+	"github.com/senzing/g2-sdk-go-mock/mockerror"
+	"github.com/senzing/g2-sdk-go-mock/notification"
+	"github.com/senzing/g2-sdk-go-mock/recorder"
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+	"github.com/senzing/g2-sdk-go-mock/resulthelpers"
+	"github.com/senzing/g2-sdk-go/g2api"
 	g2engineapi "github.com/senzing/g2-sdk-go/g2engine"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
@@ -23,10 +37,60 @@ import (
 // Types
 // ----------------------------------------------------------------------------
 
+// DataSourceProfile describes how G2engine behaves for the records of one data source.
+type DataSourceProfile struct {
+	Latency                   time.Duration // Delay added to each call for a record of the data source.
+	ErrorRate                 float64       // Fraction of calls, from 0.0 to 1.0, that return an error.
+	WithInfoTemplate          string        // Result of "...WithInfo" calls. "{DATA_SOURCE}" and "{RECORD_ID}" are replaced.
+	InterestingEntitiesResult string        // Result of FindInterestingEntitiesByRecordID().
+}
+
+// Identifies a record by data source code and record ID.
+type recordKey struct {
+	dataSourceCode string
+	recordID       string
+}
+
+// The "_MOCK" object added to responses when MockMetadata is set.
+type mockMetadataJson struct {
+	CallSequence int64  `json:"CALL_SEQUENCE"`
+	Method       string `json:"METHOD"`
+	InstanceID   string `json:"INSTANCE_ID"`
+	ScenarioName string `json:"SCENARIO_NAME"`
+}
+
+// Document returned by the "...WithInfo" methods when a WithInfo seed is set.
+type withInfoEntity struct {
+	EntityID int64 `json:"ENTITY_ID"`
+}
+
+type withInfoInteresting struct {
+	Entities []withInfoEntity `json:"ENTITIES"`
+}
+
+type withInfoJson struct {
+	DataSource          string              `json:"DATA_SOURCE"`
+	RecordID            string              `json:"RECORD_ID"`
+	AffectedEntities    []withInfoEntity    `json:"AFFECTED_ENTITIES"`
+	InterestingEntities withInfoInteresting `json:"INTERESTING_ENTITIES"`
+}
+
+// The "...WithInfo" document generated for a record of the Stateful repository.
+type storedWithInfoJson struct {
+	DataSource          string           `json:"DATA_SOURCE"`
+	RecordID            string           `json:"RECORD_ID"`
+	AffectedEntities    []withInfoEntity `json:"AFFECTED_ENTITIES"`
+	InterestingEntities struct {
+		Entities []interestingEntityJson `json:"ENTITIES"`
+	} `json:"INTERESTING_ENTITIES"`
+}
+
 type G2engine struct {
-	isTrace                                                bool
+	isTrace                                                atomic.Bool
 	logger                                                 messagelogger.MessageLoggerInterface
-	observers                                              subject.Subject
+	loggerMutex                                            sync.Mutex
+	logMutex                                               sync.Mutex
+	observers                                              observers.Registry
 	AddRecordWithInfoResult                                string
 	AddRecordWithInfoWithReturnedRecordIDResultGetWithInfo string
 	AddRecordWithInfoWithReturnedRecordIDResultRecordID    string
@@ -91,45 +155,1601 @@ type G2engine struct {
 	WhyEntityByRecordIDResult                              string
 	WhyRecords_V2Result                                    string
 	WhyRecordsResult                                       string
+	ExportLines                                            []string
+
+	CallLatency        time.Duration // Simulated duration of each call.
+	ColdStartCalls     int           // Number of calls after Init() that are slower than CallLatency.
+	ColdStartFactor    float64       // Slowdown of the first call after Init(). It decreases linearly to 1 over ColdStartCalls calls.
+	MaxConcurrentCalls int           // Calls beyond this number of in-flight calls return an error. 0 is unlimited.
+	MaxQueuedCalls     int           // Calls made while this many calls wait out their simulated latency return a retryable "engine busy" error. 0 is unlimited.
+	CanonicalJSON      bool          // Render generated "...WithInfo" results with sorted keys and stable indentation.
+	ReplicationLag     time.Duration // Delay before written records are visible to reads. Negative waits for AdvanceReplication().
+	MockMetadata       bool          // Add a "_MOCK" object identifying the call to JSON responses.
+	InstanceID         string        // Reported in "_MOCK". A unique value is generated if empty.
+	ScenarioName       string        // Reported in "_MOCK".
+	Stateful           bool          // Keep the records written by the record methods in an in-memory repository.
+	AutoFillResults    bool          // Replace empty results of methods returning JSON documents with resultbuilder.Placeholder().
+	FeatureChanges     bool          // ReplaceRecordWithInfo() of a Stateful G2engine reports the features added and removed by each replacement.
+	EntityPageSize     int           // Split RECORDS of the entities of the Stateful repository into pages of this many records, as resultbuilder.EntityDoc.PageSize() does. 0 disables paging.
+
+	RecordIDGenerator func(dataSourceCode string, jsonData string) string // Makes the record IDs of the "...WithReturnedRecordID" methods. nil uses their "...Result" fields, else resultbuilder.RecordID().
+
+	DisallowedPathMatchLevels []int                // MATCH_LEVEL values of relationships that the FindPath...() methods may not traverse.
+	PurgeDuration             time.Duration        // Simulated duration of PurgeRepository(). Mutating calls wait until it completes.
+	PurgeProgressInterval     time.Duration        // Interval between progress notifications during PurgeRepository(). 0 sends none.
+	Clock                     func() time.Time     // Current time of PurgeProgress(), ExportProgress() and records of the Stateful repository. nil is resultbuilder.Now().
+	NotificationEncoder       notification.Encoder // Encoding of observer notifications. nil is notification.JSON.
+	ObserverFaults            ObserverFaults       // Simulated failures delivering notifications to observers.
+	NotifyFailurePolicy       NotifyFailurePolicy  // Handling of notifications that could not be delivered.
+	NotifyRetries             int                  // Additional delivery attempts with NotifyRetry.
+	NotifyTimeout             time.Duration        // Deliveries blocked longer than this fail. 0 waits indefinitely.
+
+	ConfigCompatibilityVersion string           // If set, Init(), InitWithConfigID() and Reinit() fail unless the configuration's COMPATIBILITY_VERSION matches.
+	Configs                    map[int64]string // Configurations selected by InitWithConfigID() and Reinit(), by configuration ID. Others are ExportConfigResult.
+	CompressionThreshold       int              // Results of export and entity methods at least this long are returned compressed by resulthelpers.Compress(). 0 disables.
+	HandleTTL                  time.Duration    // Export handles older than this are rejected by FetchNext() as expired. 0 never expires.
+	HandleMaxFetches           int              // Export handles are rejected by FetchNext() as expired after this many FetchNext() calls. 0 is unlimited.
+	ExportPages                []string         // If not nil, each export gets a unique handle from which FetchNext() returns these pages, then "".
+
+	NotificationVerbosity NotificationVerbosity // Detail of observer notifications. The default is VerbositySummary.
+
+	Cluster          *Cluster // State shared with other G2engine instances. nil keeps the state in this instance.
+	OmitResponseKeys []string // Keys removed, at any depth, from JSON responses, e.g. to emulate the responses of an earlier version.
+	StrictIniParams  bool     // Init() and InitWithConfigID() fail unless iniParams passes iniparams.Validate().
+	StrictFlags      bool     // The "..._V2" methods reject flags with unknown bits, or bits not allowed by SetAllowedFlags().
+	RecordProvenance bool     // GetRecord_V2() returns records of the Stateful repository with a "_PROVENANCE" object.
+	Role             string   // Role of the caller. Data sources and entities restricted to other roles by RestrictDataSource() and RestrictEntity() cannot be read.
+
+	PanicOnConcurrentMutation bool             // Mock configuration methods that change results panic while calls are in flight. For debugging test setup.
+	Errors                    map[string]error // Returned by the interface methods of the names, e.g. {"AddRecord": err}. Not used by Init(), Destroy() and other methods not counted by InFlight().
+
+	// Called instead of returning the ...Result field of the method, if not nil.
+	AddRecordWithInfoFunc                    func(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error)
+	CheckRecordFunc                          func(ctx context.Context, record string, recordQueryList string) (string, error)
+	DeleteRecordWithInfoFunc                 func(ctx context.Context, dataSourceCode string, recordID string, loadID string, flags int64) (string, error)
+	ExportConfigFunc                         func(ctx context.Context) (string, error)
+	ExportConfigAndConfigIDFunc              func(ctx context.Context) (string, int64, error)
+	FetchNextFunc                            func(ctx context.Context, responseHandle uintptr) (string, error)
+	FindInterestingEntitiesByEntityIDFunc    func(ctx context.Context, entityID int64, flags int64) (string, error)
+	FindInterestingEntitiesByRecordIDFunc    func(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+	FindNetworkByEntityIDFunc                func(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error)
+	FindNetworkByEntityID_V2Func             func(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error)
+	FindNetworkByRecordIDFunc                func(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error)
+	FindNetworkByRecordID_V2Func             func(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error)
+	FindPathByEntityIDFunc                   func(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int) (string, error)
+	FindPathByEntityID_V2Func                func(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, flags int64) (string, error)
+	FindPathByRecordIDFunc                   func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int) (string, error)
+	FindPathByRecordID_V2Func                func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, flags int64) (string, error)
+	FindPathExcludingByEntityIDFunc          func(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string) (string, error)
+	FindPathExcludingByEntityID_V2Func       func(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, flags int64) (string, error)
+	FindPathExcludingByRecordIDFunc          func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string) (string, error)
+	FindPathExcludingByRecordID_V2Func       func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, flags int64) (string, error)
+	FindPathIncludingSourceByEntityIDFunc    func(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string) (string, error)
+	FindPathIncludingSourceByEntityID_V2Func func(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string, flags int64) (string, error)
+	FindPathIncludingSourceByRecordIDFunc    func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string) (string, error)
+	FindPathIncludingSourceByRecordID_V2Func func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string, flags int64) (string, error)
+	GetEntityByEntityIDFunc                  func(ctx context.Context, entityID int64) (string, error)
+	GetEntityByEntityID_V2Func               func(ctx context.Context, entityID int64, flags int64) (string, error)
+	GetEntityByRecordIDFunc                  func(ctx context.Context, dataSourceCode string, recordID string) (string, error)
+	GetEntityByRecordID_V2Func               func(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+	GetRecordFunc                            func(ctx context.Context, dataSourceCode string, recordID string) (string, error)
+	GetRecord_V2Func                         func(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+	GetRedoRecordFunc                        func(ctx context.Context) (string, error)
+	GetVirtualEntityByRecordIDFunc           func(ctx context.Context, recordList string) (string, error)
+	GetVirtualEntityByRecordID_V2Func        func(ctx context.Context, recordList string, flags int64) (string, error)
+	HowEntityByEntityIDFunc                  func(ctx context.Context, entityID int64) (string, error)
+	HowEntityByEntityID_V2Func               func(ctx context.Context, entityID int64, flags int64) (string, error)
+	ProcessRedoRecordFunc                    func(ctx context.Context) (string, error)
+	ProcessRedoRecordWithInfoFunc            func(ctx context.Context, flags int64) (string, string, error)
+	ProcessWithInfoFunc                      func(ctx context.Context, record string, flags int64) (string, error)
+	ProcessWithResponseFunc                  func(ctx context.Context, record string) (string, error)
+	ProcessWithResponseResizeFunc            func(ctx context.Context, record string) (string, error)
+	ReevaluateEntityWithInfoFunc             func(ctx context.Context, entityID int64, flags int64) (string, error)
+	ReevaluateRecordWithInfoFunc             func(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+	ReplaceRecordWithInfoFunc                func(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error)
+	SearchByAttributesFunc                   func(ctx context.Context, jsonData string) (string, error)
+	SearchByAttributes_V2Func                func(ctx context.Context, jsonData string, flags int64) (string, error)
+	StatsFunc                                func(ctx context.Context) (string, error)
+	WhyEntitiesFunc                          func(ctx context.Context, entityID1 int64, entityID2 int64) (string, error)
+	WhyEntities_V2Func                       func(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error)
+	WhyEntityByEntityIDFunc                  func(ctx context.Context, entityID int64) (string, error)
+	WhyEntityByEntityID_V2Func               func(ctx context.Context, entityID int64, flags int64) (string, error)
+	WhyEntityByRecordIDFunc                  func(ctx context.Context, dataSourceCode string, recordID string) (string, error)
+	WhyEntityByRecordID_V2Func               func(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+	WhyRecordsFunc                           func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string) (string, error)
+	WhyRecords_V2Func                        func(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error)
+
+	DestroyError            error // Returned by Destroy(), e.g. to test cleanup after a failed shutdown.
+	UnregisterObserverError error // Returned by UnregisterObserver(), which then keeps the observer registered.
+
+	IsolatedLogger bool // Use a logger whose log level is not changed by messagelogger.SetLogLevel(). See the isolatedlogger package.
+
+	Recorder        *recorder.Recorder // If set, captures each call of an interface method.
+	ResourceProfile ResourceProfile    // Host reported by Stats() when StatsResult is not set.
+
+	flagsMutex         sync.Mutex
+	flagsUsed          map[string][]int64
+	allowedFlags       map[string]int64
+	profilesMutex      sync.RWMutex
+	dataSourceProfiles map[string]DataSourceProfile
+	inFlightMutex      sync.Mutex
+	inFlight           map[string]int
+	queuedCalls        int
+	coldStartMutex     sync.Mutex
+	coldStartRemaining int
+	withInfoMutex      sync.Mutex
+	withInfoRandom     *rand.Rand
+	withInfoEntityID   int64
+	replicationMutex   sync.Mutex
+	unreplicated       map[recordKey]time.Time
+	metadataMutex      sync.Mutex
+	metadataSequence   int64
+	store              recordStore
+	paths              pathGraph
+	interesting        interestingRules
+	callPolicies       callPolicies
+	faultSchedules     faultSchedules
+	dropped            droppedNotifications
+	lastModifiedMutex  sync.Mutex
+	lastModified       map[string]int64
+	scenario           scenario
+	purgeMutex         sync.RWMutex
+	handles            handleRegistry
+	lifecycle          lifecycle
+	aliases            recordAliases
+	latencies          latencyRecorder
+	methodLatencies    methodLatencies
+	keyedResults       keyedResults
+	resultQueues       resultQueues
+	exports            exportCursors
+	callStacks         callStacks
+	fixtureSets        fixtureSets
+	notifications      sync.WaitGroup
+	resultsMutex       sync.RWMutex
+	access             accessRules
+	purge              purgeProgress
+	redo               redoQueue
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Get the Logger singleton.
+func (client *G2engine) getLogger() messagelogger.MessageLoggerInterface {
+	client.loggerMutex.Lock()
+	defer client.loggerMutex.Unlock()
+	if client.logger == nil {
+		if client.IsolatedLogger {
+			client.logger, _ = isolatedlogger.New(ProductId, idMessages(), g2engineapi.IdStatuses, messagelogger.LevelInfo)
+		} else {
+			client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, idMessages(), g2engineapi.IdStatuses, messagelogger.LevelInfo)
+		}
+	}
+	return client.logger
+}
+
+// Log a message. The logger is not safe for concurrent use, and messages are logged from notification goroutines.
+func (client *G2engine) log(messageNumber int, details ...interface{}) {
+	messageLogger := client.getLogger()
+	client.logMutex.Lock()
+	defer client.logMutex.Unlock()
+	messageLogger.Log(messageNumber, details...)
+}
+
+// Create the error of a message with the logger.
+func (client *G2engine) logError(messageNumber int, details ...interface{}) error {
+	messageLogger := client.getLogger()
+	client.logMutex.Lock()
+	defer client.logMutex.Unlock()
+	return messageLogger.Error(messageNumber, details...)
+}
+
+// Create the error of a message, as a *mockerror.Error describing the method and identifiers of the call and its class.
+func (client *G2engine) newError(methodName string, identifiers map[string]string, messageNumber int, details ...interface{}) error {
+	err := mockerror.New(client.logError(messageNumber, details...), messageNumber, methodName, identifiers)
+	return mockerror.Classify(err, mockErrorClasses[messageNumber])
+}
+
+// Get the registered observers. Returns nil if there are none.
+func (client *G2engine) getObservers() subject.Subject {
+	return client.observers.Subject()
+}
+
+// Notify registered observers.
+func (client *G2engine) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	now := time.Now()
+	details["subjectId"] = strconv.Itoa(ProductId)
+	details["messageId"] = strconv.Itoa(messageId)
+	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	if err != nil {
+		details["error"] = err.Error()
+	}
+	client.applyVerbosity(details)
+	encode := client.NotificationEncoder
+	if encode == nil {
+		encode = notification.JSON
+	}
+	message, err := encode(details)
+	if err != nil {
+		client.log(4907, messageId, err.Error())
+		return
+	}
+	client.deliver(ctx, message)
+}
+
+// Notify registered observers in a new goroutine, tracked so that Drain() can wait for it.
+func (client *G2engine) notifyAsync(notification func()) {
+	client.notifications.Add(1)
+	go func() {
+		defer client.notifications.Done()
+		notification()
+	}()
+}
+
+// Record the flags passed to a "..._V2" method.
+func (client *G2engine) recordFlags(methodName string, flags int64) {
+	client.flagsMutex.Lock()
+	defer client.flagsMutex.Unlock()
+	if client.flagsUsed == nil {
+		client.flagsUsed = map[string][]int64{}
+	}
+	client.flagsUsed[methodName] = append(client.flagsUsed[methodName], flags)
+}
+
+// Read a string "...Result" field, which may be set concurrently by SetResults().
+func (client *G2engine) stringResult(field *string) string {
+	client.resultsMutex.RLock()
+	defer client.resultsMutex.RUnlock()
+	return *field
+}
+
+// Read an int64 "...Result" field, which may be set concurrently by SetResults().
+func (client *G2engine) int64Result(field *int64) int64 {
+	client.resultsMutex.RLock()
+	defer client.resultsMutex.RUnlock()
+	return *field
+}
+
+// Read a uintptr "...Result" field, which may be set concurrently by SetResults().
+func (client *G2engine) uintptrResult(field *uintptr) uintptr {
+	client.resultsMutex.RLock()
+	defer client.resultsMutex.RUnlock()
+	return *field
+}
+
+// Wait for a duration, or until the context is done.
+func sleep(ctx context.Context, duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+	select {
+	case <-time.After(duration):
+	case <-ctx.Done():
+	}
+}
+
+// Count a call as in flight and simulate its latency.
+// If MaxConcurrentCalls would be exceeded, the call is not counted and an error is returned.
+func (client *G2engine) startCall(ctx context.Context, methodName string) error {
+	if divergence, ok := client.scenario.advance(methodName); !ok {
+		return client.newError(methodName, nil, 4909, client.ScenarioName, divergence)
+	}
+	if client.lifecycle.isDatabaseDown() {
+		return client.newError(methodName, nil, 4912, methodName)
+	}
+	if client.lifecycle.isDraining() {
+		return client.newError(methodName, nil, 4921, methodName)
+	}
+	if err := client.Errors[methodName]; err != nil {
+		return err
+	}
+	if schedule, call, fail := client.faultSchedules.fail(methodName); fail {
+		if schedule.Err != nil {
+			return schedule.Err
+		}
+		return client.newError(methodName, nil, 4923, call, methodName)
+	}
+	client.inFlightMutex.Lock()
+	total := 0
+	for _, count := range client.inFlight {
+		total += count
+	}
+	if client.MaxConcurrentCalls > 0 && total >= client.MaxConcurrentCalls {
+		client.inFlightMutex.Unlock()
+		return client.newError(methodName, nil, 4901, methodName, total, client.MaxConcurrentCalls)
+	}
+	if client.MaxQueuedCalls > 0 && client.queuedCalls >= client.MaxQueuedCalls {
+		queuedCalls := client.queuedCalls
+		client.inFlightMutex.Unlock()
+		return client.newError(methodName, nil, 4920, methodName, queuedCalls, client.MaxQueuedCalls)
+	}
+	if client.inFlight == nil {
+		client.inFlight = map[string]int{}
+	}
+	if client.PanicOnConcurrentMutation {
+		client.callStacks.add(methodName)
+	}
+	client.inFlight[methodName]++
+	client.queuedCalls++
+	client.inFlightMutex.Unlock()
+	client.simulateLatency(ctx, methodName)
+	client.inFlightMutex.Lock()
+	client.queuedCalls--
+	client.inFlightMutex.Unlock()
+	return nil
+}
+
+// Remove a call counted by startCall() from the in-flight calls, and record its duration.
+func (client *G2engine) finishCall(methodName string, entryTime time.Time) {
+	client.latencies.add(methodName, time.Since(entryTime))
+	client.callStacks.remove()
+	client.inFlightMutex.Lock()
+	defer client.inFlightMutex.Unlock()
+	client.inFlight[methodName]--
+	if client.inFlight[methodName] == 0 {
+		delete(client.inFlight, methodName)
+	}
+}
+
+// Delay a call by the latency set for its method, or CallLatency, slowed down by the cold start curve after Init().
+func (client *G2engine) simulateLatency(ctx context.Context, methodName string) {
+	latency, ok := client.methodLatencies.draw(methodName)
+	if !ok {
+		latency = client.CallLatency
+	}
+	client.coldStartMutex.Lock()
+	if client.coldStartRemaining > 0 {
+		if client.ColdStartCalls > 0 && client.ColdStartFactor > 1 {
+			factor := 1 + (client.ColdStartFactor-1)*float64(client.coldStartRemaining)/float64(client.ColdStartCalls)
+			latency = time.Duration(float64(latency) * factor)
+		}
+		client.coldStartRemaining--
+	}
+	client.coldStartMutex.Unlock()
+	sleep(ctx, latency)
+}
+
+// Set the number of calls remaining in the cold start curve.
+func (client *G2engine) setColdStart(calls int) {
+	client.coldStartMutex.Lock()
+	defer client.coldStartMutex.Unlock()
+	client.coldStartRemaining = calls
+}
+
+// Get the profile attached to a data source.
+func (client *G2engine) dataSourceProfile(dataSourceCode string) (DataSourceProfile, bool) {
+	client.profilesMutex.RLock()
+	defer client.profilesMutex.RUnlock()
+	profile, ok := client.dataSourceProfiles[dataSourceCode]
+	return profile, ok
+}
+
+// Apply the latency of a data source's profile. Returns true if the call should fail.
+func (client *G2engine) simulateDataSourceProfile(ctx context.Context, dataSourceCode string) bool {
+	profile, ok := client.dataSourceProfile(dataSourceCode)
+	if !ok {
+		return false
+	}
+	sleep(ctx, profile.Latency)
+	return profile.ErrorRate > 0 && rand.Float64() < profile.ErrorRate
+}
+
+// Return the "...WithInfo" result for a record, from the data source's profile if it has a template.
+func (client *G2engine) withInfoResult(dataSourceCode string, recordID string, defaultResult string) string {
+	profile, ok := client.dataSourceProfile(dataSourceCode)
+	if !ok || len(profile.WithInfoTemplate) == 0 {
+		if seededResult, ok := client.seededWithInfo(dataSourceCode, recordID); ok {
+			return client.canonical(seededResult)
+		}
+		if record, ok := client.records().get(dataSourceCode, recordID); ok && client.Stateful && len(defaultResult) == 0 {
+			return client.storedWithInfo(dataSourceCode, recordID, record.entityID)
+		}
+		return defaultResult
+	}
+	return client.canonical(strings.NewReplacer("{DATA_SOURCE}", dataSourceCode, "{RECORD_ID}", recordID).Replace(profile.WithInfoTemplate))
+}
+
+// Compress a result with resulthelpers.Compress() if it reaches CompressionThreshold.
+func (client *G2engine) compress(result string) string {
+	if client.CompressionThreshold <= 0 || len(result) < client.CompressionThreshold {
+		return result
+	}
+	return resulthelpers.Compress(result)
+}
+
+// Return a generated document in canonical form if CanonicalJSON is set.
+// Documents that are not valid JSON are returned unchanged.
+func (client *G2engine) canonical(document string) string {
+	if !client.CanonicalJSON {
+		return document
+	}
+	result, err := resulthelpers.Canonicalize(document)
+	if err != nil {
+		return document
+	}
+	return result
+}
+
+// Generate a "...WithInfo" document from the seeded random source, if one is set.
+// Most calls affect one new entity; about one in five merges two or three existing entities.
+func (client *G2engine) seededWithInfo(dataSourceCode string, recordID string) (string, bool) {
+	client.withInfoMutex.Lock()
+	defer client.withInfoMutex.Unlock()
+	if client.withInfoRandom == nil {
+		return "", false
+	}
+	affectedEntities := []withInfoEntity{}
+	if client.withInfoEntityID >= 2 && client.withInfoRandom.Intn(5) == 0 {
+		count := 2 + client.withInfoRandom.Intn(2)
+		if int64(count) > client.withInfoEntityID {
+			count = 2
+		}
+		entityIDs := client.withInfoRandom.Perm(int(client.withInfoEntityID))[:count]
+		sort.Ints(entityIDs)
+		for _, entityID := range entityIDs {
+			affectedEntities = append(affectedEntities, withInfoEntity{EntityID: int64(entityID + 1)})
+		}
+	} else {
+		client.withInfoEntityID++
+		affectedEntities = append(affectedEntities, withInfoEntity{EntityID: client.withInfoEntityID})
+	}
+	result, err := json.Marshal(withInfoJson{
+		DataSource:       dataSourceCode,
+		RecordID:         recordID,
+		AffectedEntities: affectedEntities,
+		InterestingEntities: withInfoInteresting{
+			Entities: []withInfoEntity{},
+		},
+	})
+	return string(result), err == nil
+}
+
+// Generate a "...WithInfo" document for a record of the Stateful repository affecting its entity,
+// with the interesting entities of the rules set with AddInterestingEntityRule().
+func (client *G2engine) storedWithInfo(dataSourceCode string, recordID string, entityID int64) string {
+	dataSourceCodes := client.records().dataSources(entityID)
+	dataSourceCodes[dataSourceCode] = true
+	document := storedWithInfoJson{
+		DataSource:       dataSourceCode,
+		RecordID:         recordID,
+		AffectedEntities: []withInfoEntity{{EntityID: entityID}},
+	}
+	document.InterestingEntities.Entities = client.interesting.entities(entityID, dataSourceCodes)
+	result, err := json.Marshal(document)
+	if err != nil {
+		return ""
+	}
+	return client.canonical(string(result))
+}
+
+// Return the FindInterestingEntitiesByRecordID() result.
+// The data source's profile takes precedence over the rules set with AddInterestingEntityRule().
+func (client *G2engine) interestingEntitiesResult(dataSourceCode string, recordID string, defaultResult string) string {
+	profile, ok := client.dataSourceProfile(dataSourceCode)
+	if ok && len(profile.InterestingEntitiesResult) > 0 {
+		return profile.InterestingEntitiesResult
+	}
+	if client.interesting.isEmpty() {
+		return defaultResult
+	}
+	record, _ := client.records().get(dataSourceCode, recordID)
+	dataSourceCodes := client.records().dataSources(record.entityID)
+	dataSourceCodes[dataSourceCode] = true
+	return client.interesting.document(record.entityID, dataSourceCodes)
+}
+
+// Return the FindInterestingEntitiesByEntityID() result, from the rules set with AddInterestingEntityRule().
+func (client *G2engine) interestingEntitiesByEntityIDResult(entityID int64, defaultResult string) string {
+	if client.interesting.isEmpty() {
+		return defaultResult
+	}
+	return client.interesting.document(entityID, client.records().dataSources(entityID))
+}
+
+// Hold back a written record from reads for ReplicationLag, or until AdvanceReplication() if negative.
+func (client *G2engine) trackReplication(dataSourceCode string, recordID string) {
+	if client.ReplicationLag == 0 {
+		return
+	}
+	client.replicationMutex.Lock()
+	defer client.replicationMutex.Unlock()
+	if client.unreplicated == nil {
+		client.unreplicated = map[recordKey]time.Time{}
+	}
+	visibleAt := time.Time{}
+	if client.ReplicationLag > 0 {
+		visibleAt = time.Now().Add(client.ReplicationLag)
+	}
+	client.unreplicated[recordKey{dataSourceCode, recordID}] = visibleAt
+}
+
+// Forget pending writes that have become visible. The caller holds replicationMutex.
+func (client *G2engine) expireReplication() {
+	now := time.Now()
+	for key, visibleAt := range client.unreplicated {
+		if !visibleAt.IsZero() && !now.Before(visibleAt) {
+			delete(client.unreplicated, key)
+		}
+	}
+}
+
+// Report whether a record is visible to reads. Records that were never written are visible.
+func (client *G2engine) isReplicated(dataSourceCode string, recordID string) bool {
+	client.replicationMutex.Lock()
+	defer client.replicationMutex.Unlock()
+	client.expireReplication()
+	_, pending := client.unreplicated[recordKey{dataSourceCode, recordID}]
+	return !pending
+}
+
+// Report whether any written record is not yet visible to reads.
+func (client *G2engine) replicationPending() bool {
+	client.replicationMutex.Lock()
+	defer client.replicationMutex.Unlock()
+	client.expireReplication()
+	return len(client.unreplicated) > 0
+}
+
+// Replace an empty response with a placeholder if AutoFillResults is set,
+// remove OmitResponseKeys from a JSON response, and add a "_MOCK" object to a JSON object response if MockMetadata is set.
+// Other responses are returned unchanged. The fields of the response keep their order, unless keys are omitted.
+func (client *G2engine) mockMetadata(methodName string, response string) string {
+	if client.AutoFillResults && len(response) == 0 {
+		response, _ = resultbuilder.Placeholder(methodName)
+	}
+	response = client.omitResponseKeys(response)
+	if !client.MockMetadata {
+		return response
+	}
+	trimmed := strings.TrimSpace(response)
+	if !strings.HasPrefix(trimmed, "{") || !json.Valid([]byte(trimmed)) {
+		return response
+	}
+	client.metadataMutex.Lock()
+	if len(client.InstanceID) == 0 {
+		client.InstanceID = strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	client.metadataSequence++
+	metadata, err := json.Marshal(mockMetadataJson{
+		CallSequence: client.metadataSequence,
+		Method:       methodName,
+		InstanceID:   client.InstanceID,
+		ScenarioName: client.ScenarioName,
+	})
+	client.metadataMutex.Unlock()
+	if err != nil {
+		return response
+	}
+	body := strings.TrimSpace(strings.TrimSuffix(trimmed, "}"))
+	separator := ","
+	if body == "{" {
+		separator = ""
+	}
+	return body + separator + `"_MOCK":` + string(metadata) + "}"
+}
+
+// Add a record for AddRecordWithInfo() and AddRecordWithInfoWithReturnedRecordID() unless err, from the earlier checks of the call, is set,
+// applying call policies, replication lag and AddRecordWithInfoFunc. Returns the "...WithInfo" result, made from defaultResult,
+// or an empty result if the record is not added.
+func (client *G2engine) addRecordWithInfo(ctx context.Context, methodName string, err error, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64, defaultResult string) (string, error) {
+	if err == nil && client.callPolicies.fail(methodName, dataSourceCode, recordID) {
+		err = client.newError(methodName, map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, methodName, dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.trackReplication(dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.storeRecord(dataSourceCode, recordID, jsonData, loadID)
+	}
+	result := ""
+	if err == nil {
+		result = client.withInfoResult(dataSourceCode, recordID, defaultResult)
+	}
+	if err == nil && client.AddRecordWithInfoFunc != nil {
+		result, err = client.AddRecordWithInfoFunc(ctx, dataSourceCode, recordID, jsonData, loadID, flags)
+	}
+	if err == nil {
+		result = client.mockMetadata(methodName, result)
+	}
+	return result, err
+}
+
+// Add or replace a record in the in-memory repository, if Stateful is set.
+func (client *G2engine) storeRecord(dataSourceCode string, recordID string, jsonData string, loadID string) {
+	if client.Stateful {
+		client.records().put(dataSourceCode, recordID, jsonData, loadID, client.now())
+	}
+}
+
+// Remove a record from the in-memory repository, if Stateful is set, and return it.
+func (client *G2engine) removeRecord(dataSourceCode string, recordID string) storedRecord {
+	if !client.Stateful {
+		return storedRecord{}
+	}
+	record, _ := client.records().delete(dataSourceCode, recordID)
+	return record
+}
+
+// Return an error if Stateful is set and a record is not in the in-memory repository.
+func (client *G2engine) checkStored(methodName string, dataSourceCode string, recordID string) error {
+	if !client.Stateful {
+		return nil
+	}
+	if _, ok := client.records().get(dataSourceCode, recordID); !ok {
+		return client.newError(methodName, map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4916, dataSourceCode, recordID)
+	}
+	return nil
+}
+
+// Find a path in the relationship graph set with AddPathEdge(), if any relationships are set.
+// Excluded entities are not traversed.
+// With the G2_FIND_PATH_PREFER_EXCLUDE flag, they are traversed if there is no other path.
+// If required data sources are given, a path that has no entity with a record of one of them
+// in the Stateful repository is reported as no path.
+func (client *G2engine) simulatePath(entityID1 int64, entityID2 int64, maxDegree int, excluded map[int64]bool, requiredDsrcs map[string]bool, flags int64) (string, bool) {
+	if client.paths.isEmpty() {
+		return "", false
+	}
+	disallowed := map[int]bool{}
+	for _, matchLevel := range client.DisallowedPathMatchLevels {
+		disallowed[matchLevel] = true
+	}
+	path, ok := client.paths.find(entityID1, entityID2, maxDegree, func(entityID int64) bool { return excluded[entityID] }, disallowed)
+	if !ok && len(excluded) > 0 && flags&int64(g2api.G2_FIND_PATH_PREFER_EXCLUDE) != 0 {
+		path, _ = client.paths.find(entityID1, entityID2, maxDegree, func(entityID int64) bool { return false }, disallowed)
+	}
+	if len(requiredDsrcs) > 0 && !client.pathIncludesDataSource(path, requiredDsrcs) {
+		path = nil
+	}
+	return client.paths.document(entityID1, entityID2, path), true
+}
+
+// Report whether an entity of a path has a record of one of the data sources in the Stateful repository.
+func (client *G2engine) pathIncludesDataSource(path []int64, dataSourceCodes map[string]bool) bool {
+	for _, entityID := range path {
+		for dataSourceCode := range client.records().dataSources(entityID) {
+			if dataSourceCodes[dataSourceCode] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Find a path between the entities of two records of the Stateful repository.
+// The entities of excluded records are not traversed.
+func (client *G2engine) simulatePathByRecordID(dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs map[string]bool, flags int64) (string, bool) {
+	record1, ok1 := client.records().get(dataSourceCode1, recordID1)
+	record2, ok2 := client.records().get(dataSourceCode2, recordID2)
+	if !ok1 || !ok2 {
+		return "", false
+	}
+	excluded := map[int64]bool{}
+	for _, key := range parseExcludedRecords(excludedRecords) {
+		if record, ok := client.records().get(key.dataSourceCode, key.recordID); ok {
+			excluded[record.entityID] = true
+		}
+	}
+	return client.simulatePath(record1.entityID, record2.entityID, maxDegree, excluded, requiredDsrcs, flags)
+}
+
+// Purge the in-memory repository, taking PurgeDuration.
+// Mutating calls wait until the purge completes.
+// Observers are notified of the progress every PurgeProgressInterval.
+func (client *G2engine) simulatePurge(ctx context.Context) {
+	client.purgeMutex.Lock()
+	defer client.purgeMutex.Unlock()
+	startTime := time.Now()
+	client.purge.begin(client.now(), client.PurgeDuration)
+	defer client.purge.end()
+	for client.PurgeProgressInterval > 0 && time.Since(startTime)+client.PurgeProgressInterval < client.PurgeDuration {
+		sleep(ctx, client.PurgeProgressInterval)
+		if ctx.Err() != nil {
+			break
+		}
+		if client.getObservers() != nil {
+			percentComplete := int(100 * time.Since(startTime) / client.PurgeDuration)
+			client.notify(ctx, 8901, nil, map[string]string{
+				"percentComplete": strconv.Itoa(percentComplete),
+			})
+		}
+	}
+	sleep(ctx, client.PurgeDuration-time.Since(startTime))
+	client.records().purge()
+}
+
+// Return the latest of GetRepositoryLastModifiedTimeResult and the data sources' last modified times.
+func (client *G2engine) repositoryLastModifiedTime() int64 {
+	client.lastModifiedMutex.Lock()
+	defer client.lastModifiedMutex.Unlock()
+	result := client.int64Result(&client.GetRepositoryLastModifiedTimeResult)
+	for _, lastModifiedTime := range client.lastModified {
+		if lastModifiedTime > result {
+			result = lastModifiedTime
+		}
+	}
+	return result
+}
+
+// Return an error if StrictIniParams is set and iniParams is not a valid engine configuration.
+func (client *G2engine) checkIniParams(methodName string, iniParams string) error {
+	if !client.StrictIniParams {
+		return nil
+	}
+	if err := iniparams.Validate(iniParams); err != nil {
+		return client.newError(methodName, nil, 4913, err.Error())
+	}
+	return nil
+}
+
+// Trace method entry.
+func (client *G2engine) traceEntry(errorNumber int, details ...interface{}) {
+	client.log(errorNumber, details...)
+}
+
+// Trace method exit.
+func (client *G2engine) traceExit(errorNumber int, details ...interface{}) {
+	client.log(errorNumber, details...)
+}
+
+// ----------------------------------------------------------------------------
+// Mock configuration methods
+// ----------------------------------------------------------------------------
+
+/*
+The SetDataSourceProfile method attaches behavior to a data source.
+It applies to the methods that identify a record by data source code and record ID,
+e.g. AddRecord(), GetRecord(), DeleteRecordWithInfo() and FindInterestingEntitiesByRecordID().
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - profile: The behavior of calls for records of the data source.
+*/
+func (client *G2engine) SetDataSourceProfile(dataSourceCode string, profile DataSourceProfile) {
+	client.checkMutation("SetDataSourceProfile")
+	client.profilesMutex.Lock()
+	defer client.profilesMutex.Unlock()
+	if client.dataSourceProfiles == nil {
+		client.dataSourceProfiles = map[string]DataSourceProfile{}
+	}
+	client.dataSourceProfiles[dataSourceCode] = profile
+}
+
+/*
+The SetWithInfoSeed method makes the "...WithInfo" record methods generate their results
+from a pseudo-random source, instead of returning the "...WithInfoResult" fields.
+AFFECTED_ENTITIES varies between calls, sometimes reporting a new entity and sometimes a merge,
+but the sequence of results is the same for the same seed and sequence of calls.
+Results from SetDataSourceProfile() templates take precedence.
+
+Input
+  - seed: The seed of the pseudo-random source.
+*/
+func (client *G2engine) SetWithInfoSeed(seed int64) {
+	client.checkMutation("SetWithInfoSeed")
+	client.withInfoMutex.Lock()
+	defer client.withInfoMutex.Unlock()
+	client.withInfoRandom = rand.New(rand.NewSource(seed))
+	client.withInfoEntityID = 0
+}
+
+/*
+The AdvanceReplication method makes all records written so far visible to reads,
+regardless of ReplicationLag.
+*/
+func (client *G2engine) AdvanceReplication() {
+	client.checkMutation("AdvanceReplication")
+	client.replicationMutex.Lock()
+	defer client.replicationMutex.Unlock()
+	client.unreplicated = nil
+}
+
+/*
+The SetCallPolicy method makes repeated calls of a method for one record succeed or fail,
+e.g. to test that a loader does not retry a call that succeeded, or that it retries one that failed.
+Failing calls return a duplicate record error.
+Setting a policy restarts the count of calls for the method and record.
+It applies to AddRecord(), AddRecordWithReturnedRecordID(), DeleteRecord(), ReevaluateRecord(), ReplaceRecord() and their "...WithInfo" variants.
+
+Input
+  - methodName: The name of the method, e.g. "AddRecord".
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - policy: SucceedThenFail or FailThenSucceed.
+*/
+func (client *G2engine) SetCallPolicy(methodName string, dataSourceCode string, recordID string, policy CallPolicy) {
+	client.checkMutation("SetCallPolicy")
+	client.callPolicies.set(methodName, dataSourceCode, recordID, policy)
+}
+
+/*
+The ScheduleFault method makes the Nth call of a method fail, and optionally the calls after it,
+e.g. to test that a batch loader stops at the 100th AddRecord() and resumes from it.
+Unlike Errors, calls before and after the failures succeed.
+Scheduling a fault restarts the count of calls for the method.
+It applies to the interface methods counted by InFlight().
+
+Input
+  - methodName: The name of the method, e.g. "AddRecord".
+  - schedule: The calls that fail, e.g. FaultSchedule{Call: 100, Failures: 1} to fail only the 100th call.
+*/
+func (client *G2engine) ScheduleFault(methodName string, schedule FaultSchedule) {
+	client.checkMutation("ScheduleFault")
+	client.faultSchedules.set(methodName, schedule)
+}
+
+/*
+The WithScope method applies temporary configuration for the duration of a function.
+The "...Result" fields, latency and other exported fields, data source profiles, call policies
+and latencies set with SetLatency() and SetLatencyRange() by the function are reverted when it returns, even if it panics.
+Scopes may be nested.
+
+Input
+  - scope: A function that configures the G2engine passed to it and makes calls under that configuration.
+*/
+func (client *G2engine) WithScope(scope func(scoped *G2engine)) {
+	client.checkMutation("WithScope")
+	snapshot := client.saveScope()
+	defer client.restoreScope(snapshot)
+	scope(client)
+}
+
+/*
+The SetDataSourceLastModifiedTime method sets the last modified time of the records of a data source.
+GetRepositoryLastModifiedTime() returns the latest of these times and GetRepositoryLastModifiedTimeResult.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - lastModifiedTime: A Unix Timestamp, in seconds.
+*/
+func (client *G2engine) SetDataSourceLastModifiedTime(dataSourceCode string, lastModifiedTime int64) {
+	client.checkMutation("SetDataSourceLastModifiedTime")
+	client.lastModifiedMutex.Lock()
+	defer client.lastModifiedMutex.Unlock()
+	if client.lastModified == nil {
+		client.lastModified = map[string]int64{}
+	}
+	client.lastModified[dataSourceCode] = lastModifiedTime
+}
+
+/*
+The RunScenario method starts a scenario: the sequence of calls the code under test is expected to make.
+Each call is matched against the next step. Once a call does not match, it and every later call return an error
+describing the position in the scenario, so tests fail fast. Use ScenarioProgress() to report the position.
+Calling RunScenario() again restarts from the first step.
+
+Input
+  - steps: The calls expected, in order.
+*/
+func (client *G2engine) RunScenario(steps ...ScenarioStep) {
+	client.checkMutation("RunScenario")
+	client.scenario.start(steps)
+}
+
+/*
+The AddPathEdge method adds a relationship to the graph searched by the FindPath...() methods.
+Once a relationship is added, those methods return the lowest-cost path in the graph
+instead of their "...Result" fields.
+The "...ByRecordID" variants use the entities of records in the Stateful repository.
+The relationship is reported, with its IS_DISCLOSED and IS_AMBIGUOUS flags, in the RELATED_ENTITIES
+of the entities of those paths and of the entities built from the Stateful repository.
+
+Input
+  - edge: The relationship. It replaces any relationship between the same entities.
+*/
+func (client *G2engine) AddPathEdge(edge PathEdge) {
+	client.checkMutation("AddPathEdge")
+	client.paths.add(edge)
+}
+
+/*
+The AddInterestingEntityRule method adds a rule to the FindInterestingEntitiesBy...() methods.
+Once a rule is added, those methods report the interesting entities of the rules that apply
+to the queried entity, instead of their "...Result" fields.
+
+Input
+  - rule: The entities and data sources the rule applies to, and the interesting entity reported.
+*/
+func (client *G2engine) AddInterestingEntityRule(rule InterestingEntityRule) {
+	client.checkMutation("AddInterestingEntityRule")
+	client.interesting.add(rule)
+}
+
+/*
+The SimulateRestart method makes the G2engine behave as if the engine had been restarted,
+so code that reconnects or re-initializes can be tested.
+Calls after the restart are cold again, as after Init(), until PrimeEngine() is called or ColdStartCalls calls are made.
+Export handles issued before the restart are rejected by FetchNext() and CloseExport().
+The CALL_SEQUENCE of MockMetadata and the flags reported by FlagsUsed() start over.
+Records in the Stateful repository and the mock configuration are kept.
+*/
+func (client *G2engine) SimulateRestart() {
+	client.checkMutation("SimulateRestart")
+	client.setColdStart(client.ColdStartCalls)
+	client.handles.invalidate()
+	client.metadataMutex.Lock()
+	client.metadataSequence = 0
+	client.metadataMutex.Unlock()
+	client.flagsMutex.Lock()
+	client.flagsUsed = nil
+	client.flagsMutex.Unlock()
+}
+
+/*
+The SetRecordAlias method maps a record ID, e.g. a legacy one, to the current ID of the record,
+so lookups by the old identifier can be made to succeed or fail on demand.
+It applies to GetEntityByRecordID() and GetEntityByRecordID_V2().
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - aliasRecordID: The record ID used by the lookups.
+  - recordID: The current record ID.
+  - resolvable: True to look up the current record ID, false to fail with an unknown record error.
+*/
+func (client *G2engine) SetRecordAlias(dataSourceCode string, aliasRecordID string, recordID string, resolvable bool) {
+	client.checkMutation("SetRecordAlias")
+	client.aliases.set(dataSourceCode, aliasRecordID, recordAlias{recordID: recordID, resolvable: resolvable})
+}
+
+/*
+The MergeRecords method resolves two records of the Stateful repository to the same entity:
+the records of the entity of the second record move to the entity of the first.
+It is used to build entities with records of several data sources.
+
+Input
+  - dataSourceCode1: Identifies the provenance of the record whose entity is kept.
+  - recordID1: The unique identifier within the records of the same data source.
+  - dataSourceCode2: Identifies the provenance of the record whose entity is merged.
+  - recordID2: The unique identifier within the records of the same data source.
+
+Output
+  - False if either record is not in the repository.
+*/
+func (client *G2engine) MergeRecords(dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string) bool {
+	client.checkMutation("MergeRecords")
+	return client.records().merge(recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+}
+
+/*
+The OnStateTransition method adds a callback called on lifecycle transitions of the G2engine,
+so test orchestration code can wait for a state without polling.
+Callbacks are called synchronously by the method making the transition, after it succeeds,
+in the order they were added.
+
+Input
+  - callback: A function called with the new state, e.g. StateInitialized or StatePurged.
+*/
+func (client *G2engine) OnStateTransition(callback func(state State)) {
+	client.checkMutation("OnStateTransition")
+	client.lifecycle.add(callback)
+}
+
+/*
+The SetDatabaseDown method simulates the loss and recovery of the database.
+While it is down, calls fail, except the lifecycle, logging and observer methods.
+Changing the state calls the OnStateTransition() callbacks with StateDatabaseDown or StateDatabaseUp.
+
+Input
+  - down: True to take the database down, false to bring it back.
+*/
+func (client *G2engine) SetDatabaseDown(down bool) {
+	client.checkMutation("SetDatabaseDown")
+	if !client.lifecycle.setDatabaseDown(down) {
+		return
+	}
+	if down {
+		client.lifecycle.transition(StateDatabaseDown)
+	} else {
+		client.lifecycle.transition(StateDatabaseUp)
+	}
+}
+
+/*
+The Drain method stops the G2engine accepting calls, then waits for in-flight calls to finish and for pending notifications to be passed to the observers.
+Calls made while draining, or after, return an error, except the lifecycle, logging and observer methods.
+The first time it finishes, it calls the OnStateTransition() callbacks with StateDrained.
+If ctx is done first, Drain() can be called again to keep waiting.
+Only the G2engine is drained: notifications of the other mock objects are not waited for.
+
+Input
+  - ctx: A context to control lifecycle. If it is done before draining finishes, its error is returned.
+*/
+func (client *G2engine) Drain(ctx context.Context) error {
+	client.lifecycle.startDraining()
+	ticker := time.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	for len(client.InFlight()) > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	delivered := make(chan struct{})
+	go func() {
+		client.notifications.Wait()
+		close(delivered)
+	}()
+	select {
+	case <-delivered:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if client.lifecycle.finishDraining() {
+		client.lifecycle.transition(StateDrained)
+	}
+	return nil
+}
+
+/*
+The RestrictDataSource method restricts a data source to roles, to simulate data-access policies.
+Calls of Role that are not one of the roles get a permission denied error
+from the methods taking data source codes, e.g. GetRecord() and WhyRecords(), and an empty result.
+Restricting a data source again replaces its roles.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - roles: The roles allowed to read the data source. With none, no role may read it.
+*/
+func (client *G2engine) RestrictDataSource(dataSourceCode string, roles ...string) {
+	client.checkMutation("RestrictDataSource")
+	client.access.restrictDataSource(dataSourceCode, roles)
+}
+
+/*
+The RestrictEntity method restricts an entity to roles, to simulate data-access policies.
+Calls of Role that are not one of the roles get a permission denied error
+from the methods taking entity IDs, e.g. GetEntityByEntityID() and WhyEntities(), and an empty result.
+The methods taking records of the Stateful repository, e.g. GetRecord(), check the entities of the records too.
+Restricting an entity again replaces its roles.
+
+Input
+  - entityID: The unique identifier of an entity.
+  - roles: The roles allowed to read the entity. With none, no role may read it.
+*/
+func (client *G2engine) RestrictEntity(entityID int64, roles ...string) {
+	client.checkMutation("RestrictEntity")
+	client.access.restrictEntity(entityID, roles)
+}
+
+/*
+The SetLatency method sets the simulated latency of a method, replacing CallLatency for its calls,
+e.g. to test timeouts and worker-pool sizing against realistic response times.
+The cold start curve still applies.
+
+Input
+  - methodName: The name of an interface method, e.g. "SearchByAttributes".
+  - latency: The delay of each call.
+*/
+func (client *G2engine) SetLatency(methodName string, latency time.Duration) {
+	client.checkMutation("SetLatency")
+	client.methodLatencies.set(methodName, latencyRange{min: latency, max: latency})
+}
+
+/*
+The SetLatencyRange method sets the simulated latency of a method to a random delay from minimum to maximum,
+replacing CallLatency for its calls. The cold start curve still applies.
+
+Input
+  - methodName: The name of an interface method, e.g. "SearchByAttributes".
+  - minimum: The shortest delay.
+  - maximum: The longest delay.
+*/
+func (client *G2engine) SetLatencyRange(methodName string, minimum time.Duration, maximum time.Duration) {
+	client.checkMutation("SetLatencyRange")
+	client.methodLatencies.set(methodName, latencyRange{min: minimum, max: maximum})
+}
+
+/*
+The ImportScenario method configures the G2engine from a document written by ExportScenario(),
+or by any tool writing the same format.
+"...Result" fields, record results and data source profiles not in the document are kept.
+
+Input
+  - document: A JSON document, e.g. {"RESULTS":{...},"KEYED_RESULTS":{...},"CHAOS_PROFILE":{...}}.
+
+Output
+  - An error if the document cannot be read. Nothing is configured then.
+*/
+func (client *G2engine) ImportScenario(document string) error {
+	client.checkMutation("ImportScenario")
+	scenarioDocument := scenarioDocumentJson{}
+	if err := json.Unmarshal([]byte(document), &scenarioDocument); err != nil {
+		return err
+	}
+	profiles := map[string]DataSourceProfile{}
+	for dataSourceCode, profile := range scenarioDocument.ChaosProfile {
+		dataSourceProfile, err := profile.dataSourceProfile()
+		if err != nil {
+			return err
+		}
+		profiles[dataSourceCode] = dataSourceProfile
+	}
+	if err := client.SetResults(scenarioDocument.Results); err != nil {
+		return err
+	}
+	for methodName, keyedResults := range scenarioDocument.KeyedResults {
+		for _, keyedResult := range keyedResults {
+			client.keyedResults.set(methodName, keyedResult.DataSource, keyedResult.RecordID, keyedResult.Result)
+		}
+	}
+	for dataSourceCode, profile := range profiles {
+		client.SetDataSourceProfile(dataSourceCode, profile)
+	}
+	return nil
+}
+
+/*
+The SetResults method sets "...Result" fields by name with resulthelpers.SetResults().
+Unlike assigning the fields directly, it may be called while other goroutines call the G2engine.
+
+Input
+  - results: The values of the fields, by field name, e.g. {"GetRecordResult": `{"RECORD_ID":"1001"}`}.
+*/
+func (client *G2engine) SetResults(results map[string]interface{}) error {
+	client.checkMutation("SetResults")
+	client.resultsMutex.Lock()
+	defer client.resultsMutex.Unlock()
+	return resulthelpers.SetResults(client, results)
+}
+
+/*
+The SetRecordResult method sets the result of GetRecord() and GetRecord_V2() for one record,
+so that tests fetching several records can tell them apart.
+Other records get GetRecordResult or GetRecord_V2Result.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - result: The JSON document returned for the record.
+*/
+func (client *G2engine) SetRecordResult(dataSourceCode string, recordID string, result string) {
+	client.checkMutation("SetRecordResult")
+	client.keyedResults.set("GetRecord", dataSourceCode, recordID, result)
+}
+
+/*
+The RegisterFixtureSet method registers a named set of results, e.g. "happy-path", "merge-heavy" or "errors",
+so that one G2engine can serve several test suites in turn by switching sets with UseFixtureSet().
+Registering a set again replaces it. The active set is not changed until UseFixtureSet() is called.
+
+Input
+  - name: The name of the fixture set.
+  - fixtureSet: The results, by the name of their "...Result" field. See also ReadFixtureSet().
+*/
+func (client *G2engine) RegisterFixtureSet(name string, fixtureSet FixtureSet) {
+	client.checkMutation("RegisterFixtureSet")
+	client.fixtureSets.register(name, fixtureSet)
+}
+
+/*
+The UseFixtureSet method sets the "...Result" fields to a fixture set registered with RegisterFixtureSet().
+Fields not in the set get the values they had before the first fixture set was used.
+
+Input
+  - name: The name of the fixture set.
+
+Output
+  - An error if no fixture set of the name is registered,
+    or it sets a field that is not a "...Result" field of type string.
+*/
+func (client *G2engine) UseFixtureSet(name string) error {
+	client.checkMutation("UseFixtureSet")
+	return client.useFixtureSet(name)
+}
+
+/*
+The EnqueueFetchNextResult method queues results for successive calls of FetchNext(),
+so that export loops, which call FetchNext() until it returns an empty string, can be tested, e.g.
+
+	g2engine.EnqueueFetchNextResult(chunk1, chunk2, "")
+
+Each call of FetchNext() returns the next queued result.
+When the queue is empty, FetchNext() returns FetchNextResult.
+
+Input
+  - results: The results, in the order FetchNext() returns them.
+*/
+func (client *G2engine) EnqueueFetchNextResult(results ...string) {
+	client.checkMutation("EnqueueFetchNextResult")
+	client.resultQueues.push("FetchNext", results...)
+}
+
+/*
+The PushRedoRecord method appends records to the redo queue,
+so that redoer loops calling CountRedoRecords(), GetRedoRecord() and ProcessRedoRecord() can be tested.
+Once it has been called, these methods use the queue instead of their "...Result" fields.
+
+Input
+  - redoRecords: JSON documents of redo records, in the order they are returned.
+*/
+func (client *G2engine) PushRedoRecord(redoRecords ...string) {
+	client.checkMutation("PushRedoRecord")
+	client.redo.push(redoRecords...)
+}
+
+/*
+The EnqueueGetRedoRecordResult method queues results for successive calls of GetRedoRecord(),
+so that redo loops, which call GetRedoRecord() until it returns an empty string, can be tested.
+When the queue is empty, GetRedoRecord() returns GetRedoRecordResult.
+
+Input
+  - results: The results, in the order GetRedoRecord() returns them.
+*/
+func (client *G2engine) EnqueueGetRedoRecordResult(results ...string) {
+	client.checkMutation("EnqueueGetRedoRecordResult")
+	client.resultQueues.push("GetRedoRecord", results...)
+}
+
+/*
+The SetEntityByRecordIDResult method sets the result of GetEntityByRecordID() and GetEntityByRecordID_V2()
+for one record, so that tests fetching the entities of several records can tell them apart.
+Other records get GetEntityByRecordIDResult or GetEntityByRecordID_V2Result.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - result: The JSON document returned for the entity of the record.
+*/
+func (client *G2engine) SetEntityByRecordIDResult(dataSourceCode string, recordID string, result string) {
+	client.checkMutation("SetEntityByRecordIDResult")
+	client.keyedResults.set("GetEntityByRecordID", dataSourceCode, recordID, result)
+}
+
+/*
+The SetAllowedFlags method restricts the flags a "..._V2" method accepts when StrictFlags is set,
+e.g. to catch a caller passing G2_EXPORT_... flags to GetEntityByEntityID_V2().
+Methods without an allowlist accept any known G2 flag.
+
+Input
+  - methodName: The name of the method, e.g. "GetEntityByEntityID_V2".
+  - flags: The flags allowed, e.g. int64(g2api.G2_ENTITY_DEFAULT_FLAGS).
+*/
+func (client *G2engine) SetAllowedFlags(methodName string, flags int64) {
+	client.checkMutation("SetAllowedFlags")
+	client.flagsMutex.Lock()
+	defer client.flagsMutex.Unlock()
+	if client.allowedFlags == nil {
+		client.allowedFlags = map[string]int64{}
+	}
+	client.allowedFlags[methodName] = flags
 }
 
 // ----------------------------------------------------------------------------
-// Internal methods
+// Mock inspection methods
 // ----------------------------------------------------------------------------
 
-// Get the Logger singleton.
-func (client *G2engine) getLogger() messagelogger.MessageLoggerInterface {
-	if client.logger == nil {
-		client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, g2engineapi.IdMessages, g2engineapi.IdStatuses, messagelogger.LevelInfo)
+/*
+The LogLevel method returns the log level of the logger of the G2engine,
+so that tests can check it with isolatedlogger.CheckLevels().
+
+Output
+  - The log level, e.g. logger.LevelInfo.
+*/
+func (client *G2engine) LogLevel() logger.Level {
+	messageLogger := client.getLogger()
+	client.logMutex.Lock()
+	defer client.logMutex.Unlock()
+	return logger.Level(messageLogger.GetLogLevel())
+}
+
+/*
+The DataSourceLastModifiedTime method returns the last modified time set for a data source
+with SetDataSourceLastModifiedTime().
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+
+Output
+  - A Unix Timestamp, in seconds.
+  - False if no time is set for the data source.
+*/
+func (client *G2engine) DataSourceLastModifiedTime(dataSourceCode string) (int64, bool) {
+	client.lastModifiedMutex.Lock()
+	defer client.lastModifiedMutex.Unlock()
+	lastModifiedTime, ok := client.lastModified[dataSourceCode]
+	return lastModifiedTime, ok
+}
+
+/*
+The ActiveFixtureSet method returns the name of the fixture set selected by UseFixtureSet().
+
+Output
+  - The name of the fixture set, or an empty string if none is in use.
+*/
+func (client *G2engine) ActiveFixtureSet() string {
+	return client.fixtureSets.getActive()
+}
+
+/*
+The PurgeProgress method reports the progress of the last PurgeRepository(), which takes PurgeDuration,
+for consumers that poll instead of observing progress notifications.
+
+Output
+  - The progress, with an ETA at the end of PurgeDuration.
+  - False if PurgeRepository() has not been called.
+*/
+func (client *G2engine) PurgeProgress() (Progress, bool) {
+	return client.purge.at(client.now())
+}
+
+/*
+The ExportProgress method reports the progress of an export of ExportPages,
+estimating its completion from the time taken to fetch the pages so far.
+
+Input
+  - responseHandle: A handle returned by ExportJSONEntityReport() or ExportCSVEntityReport() while ExportPages is set.
+
+Output
+  - The progress. The ETA is zero until a page has been fetched.
+  - False if the handle is not an open export of ExportPages.
+*/
+func (client *G2engine) ExportProgress(responseHandle uintptr) (Progress, bool) {
+	return client.exports.progress(responseHandle, client.now())
+}
+
+/*
+The ExportScenario method writes the configured scenario as a language-neutral JSON document
+that ImportScenario() can read:
+"RESULTS" has the "...Result" fields that are set, by field name,
+"KEYED_RESULTS" the results set for individual records, e.g. with SetRecordResult(), by method name,
+and "CHAOS_PROFILE" the data source profiles in the format of SENZING_MOCK_CHAOS_PROFILE.
+
+Output
+  - The JSON document.
+*/
+func (client *G2engine) ExportScenario() (string, error) {
+	document, err := json.Marshal(scenarioDocumentJson{
+		Results:      client.nonZeroResults(),
+		KeyedResults: client.keyedResults.all(),
+		ChaosProfile: client.chaosProfile(),
+	})
+	return string(document), err
+}
+
+/*
+The RedoRecordCount method returns the number of redo records reported by CountRedoRecords().
+
+Output
+  - The number of records in the redo queue once PushRedoRecord() has been called, else CountRedoRecordsResult.
+*/
+func (client *G2engine) RedoRecordCount() int64 {
+	if count, ok := client.redo.count(); ok {
+		return count
 	}
-	return client.logger
+	return client.int64Result(&client.CountRedoRecordsResult)
 }
 
-// Notify registered observers.
-func (client *G2engine) notify(ctx context.Context, messageId int, err error, details map[string]string) {
-	now := time.Now()
-	details["subjectId"] = strconv.Itoa(ProductId)
-	details["messageId"] = strconv.Itoa(messageId)
-	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
-	if err != nil {
-		details["error"] = err.Error()
+/*
+The QueuedResultCount method returns the number of results queued for a method
+with EnqueueFetchNextResult() or EnqueueGetRedoRecordResult() that have not been returned yet.
+
+Input
+  - methodName: The name of the method, e.g. "FetchNext".
+
+Output
+  - The number of queued results.
+*/
+func (client *G2engine) QueuedResultCount(methodName string) int {
+	return client.resultQueues.count(methodName)
+}
+
+/*
+The ScenarioProgress method reports the position of the calls made in the scenario started by RunScenario():
+the steps satisfied, the steps remaining and, if a call did not match, the divergence.
+*/
+func (client *G2engine) ScenarioProgress() ScenarioProgress {
+	return client.scenario.progress()
+}
+
+/*
+The DroppedNotifications method returns the notifications that could not be delivered to observers,
+in the order they were dropped.
+*/
+func (client *G2engine) DroppedNotifications() []string {
+	return client.dropped.list()
+}
+
+/*
+The FlagsUsed method returns the flags passed to a "..._V2" method, one entry per call, in call order.
+Use DecodeFlags() to translate an entry into G2 flag names.
+
+Input
+  - methodName: The name of the method, e.g. "GetEntityByEntityID_V2".
+
+Output
+  - The flags of each call. Empty if the method has not been called.
+*/
+func (client *G2engine) FlagsUsed(methodName string) []int64 {
+	client.flagsMutex.Lock()
+	defer client.flagsMutex.Unlock()
+	return append([]int64{}, client.flagsUsed[methodName]...)
+}
+
+/*
+The InFlight method returns the number of calls currently in progress, by method name.
+Methods with no calls in progress are omitted.
+
+Output
+  - A map of method name to the number of calls in progress.
+*/
+func (client *G2engine) InFlight() map[string]int {
+	client.inFlightMutex.Lock()
+	defer client.inFlightMutex.Unlock()
+	result := map[string]int{}
+	for methodName, count := range client.inFlight {
+		result[methodName] = count
+	}
+	return result
+}
+
+/*
+The EntityCount method returns the number of entities in the in-memory repository of a Stateful G2engine.
+Each record resolves to an entity of its own, unless merged with MergeRecords().
+
+Output
+  - The number of entities.
+*/
+func (client *G2engine) EntityCount() int {
+	return client.records().entityCount()
+}
+
+/*
+The RecordCount method returns the number of records in the in-memory repository of a Stateful G2engine.
+
+Output
+  - The number of records.
+*/
+func (client *G2engine) RecordCount() int {
+	return client.records().recordCount()
+}
+
+/*
+The RecordCountByDataSource method returns the number of records in the in-memory repository
+of a Stateful G2engine, by data source code.
+Data sources with no records are omitted.
+
+Output
+  - A map of data source code to the number of records.
+*/
+func (client *G2engine) RecordCountByDataSource() map[string]int {
+	return client.records().recordCountByDataSource()
+}
+
+/*
+The FindStoredRecords method returns the records in the in-memory repository of a Stateful G2engine
+that have all the attribute values of a filter, e.g. {"ADDR_STATE": "LA"}.
+An attribute matches a top-level attribute of a record, or an attribute of an object in one of its lists.
+An empty filter matches every record.
+
+Input
+  - filter: A map of attribute name to the value the attribute must have.
+
+Output
+  - The matching records, sorted by data source code and record ID.
+*/
+func (client *G2engine) FindStoredRecords(filter map[string]string) []StoredRecord {
+	return client.records().find(filter)
+}
+
+/*
+The StoredRecordProvenance method returns the provenance of a record in the in-memory repository of a Stateful G2engine:
+the loadID of the last call that wrote it, when it was added, and how many times it was replaced.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+
+Output
+  - The provenance of the record.
+  - False if the record is not in the repository.
+*/
+func (client *G2engine) StoredRecordProvenance(dataSourceCode string, recordID string) (Provenance, bool) {
+	record, ok := client.records().get(dataSourceCode, recordID)
+	if !ok {
+		return Provenance{}, false
 	}
-	message, err := json.Marshal(details)
-	if err != nil {
-		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
+	return record.export().Provenance, true
+}
+
+/*
+The RecordFeatures method returns the features the mock derives from a record of the Stateful repository,
+e.g. NAME, NAME_KEY, PHONE and PHONE_KEY values.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+
+Output
+  - The values of each feature type, unique and sorted.
+  - False if the record is not in the repository.
+*/
+func (client *G2engine) RecordFeatures(dataSourceCode string, recordID string) (map[string][]string, bool) {
+	record, ok := client.records().get(dataSourceCode, recordID)
+	if !ok {
+		return nil, false
 	}
+	return deriveFeatures(record.jsonData), true
 }
 
-// Trace method entry.
-func (client *G2engine) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+/*
+The LatencySummaries method summarizes the durations of the calls made so far, by method,
+including the latency simulated by CallLatency, the cold start curve and data source profiles,
+so test output can correlate the timeouts of the code under test with the injected conditions.
+
+Output
+  - A map of method name to the summary of its calls.
+*/
+func (client *G2engine) LatencySummaries() map[string]LatencySummary {
+	return client.latencies.summaries()
 }
 
-// Trace method exit.
-func (client *G2engine) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+/*
+The LatencyReport method formats LatencySummaries() for test output, one method per line, sorted by method name,
+e.g. "AddRecord: n=20 p50=5ms p95=12ms max=15ms".
+
+Output
+  - The report. Empty if no calls were made.
+*/
+func (client *G2engine) LatencyReport() string {
+	summaries := client.LatencySummaries()
+	methodNames := make([]string, 0, len(summaries))
+	for methodName := range summaries {
+		methodNames = append(methodNames, methodName)
+	}
+	sort.Strings(methodNames)
+	lines := []string{}
+	for _, methodName := range methodNames {
+		lines = append(lines, fmt.Sprintf("%s: %s", methodName, summaries[methodName]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ----------------------------------------------------------------------------
+// Mock streaming methods
+// ----------------------------------------------------------------------------
+
+/*
+The ExportJSONEntityReportPages method is an alternative to ExportJSONEntityReport() and FetchNext().
+It delivers ExportLines in pages over a bounded channel.
+When the channel is full, the export waits for the consumer, so slow consumers can be tested.
+The channel is closed after the last page, or when the context is done.
+
+Input
+  - ctx: A context to control lifecycle.
+  - flags: Flags used to control information returned.
+  - pageSize: The maximum number of lines in a page. Values less than 1 are treated as 1.
+  - bufferSize: The number of pages buffered before the export waits for the consumer.
+
+Output
+  - A channel of pages of export lines.
+*/
+func (client *G2engine) ExportJSONEntityReportPages(ctx context.Context, flags int64, pageSize int, bufferSize int) <-chan []string {
+	client.recordFlags("ExportJSONEntityReportPages", flags)
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+	lines := append([]string{}, client.ExportLines...)
+	pages := make(chan []string, bufferSize)
+	go func() {
+		defer close(pages)
+		for start := 0; start < len(lines); start += pageSize {
+			end := start + pageSize
+			if end > len(lines) {
+				end = len(lines)
+			}
+			select {
+			case pages <- lines[start:end]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return pages
 }
 
 // ----------------------------------------------------------------------------
@@ -147,22 +1767,41 @@ Input
   - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
 */
 func (client *G2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(1, dataSourceCode, recordID, jsonData, loadID)
 	}
+	client.Recorder.Record("g2engine", "AddRecord", dataSourceCode, recordID, jsonData, loadID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "AddRecord"); err == nil {
+		defer client.finishCall("AddRecord", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("AddRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4001, dataSourceCode, recordID, jsonData, loadID, -1)
+	}
+	if err == nil && client.callPolicies.fail("AddRecord", dataSourceCode, recordID) {
+		err = client.newError("AddRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "AddRecord", dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.trackReplication(dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.storeRecord(dataSourceCode, recordID, jsonData, loadID)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "jsonData", jsonData)
 			client.notify(ctx, 8001, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(2, dataSourceCode, recordID, jsonData, loadID, err, time.Since(entryTime))
 	}
 	return err
@@ -170,6 +1809,8 @@ func (client *G2engine) AddRecord(ctx context.Context, dataSourceCode string, re
 
 /*
 The AddRecordWithInfo method adds a record into the Senzing repository and returns information on the affected entities.
+In the mock, if Stateful is set and AddRecordWithInfoResult is empty, the result lists the entity the record resolves to
+and the interesting entities of the rules set with AddInterestingEntityRule().
 
 Input
   - ctx: A context to control lifecycle.
@@ -184,29 +1825,45 @@ Output
     See the example output.
 */
 func (client *G2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(3, dataSourceCode, recordID, jsonData, loadID, flags)
 	}
+	client.Recorder.Record("g2engine", "AddRecordWithInfo", dataSourceCode, recordID, jsonData, loadID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "AddRecordWithInfo"); err == nil {
+		defer client.finishCall("AddRecordWithInfo", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("AddRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4002, dataSourceCode, recordID, jsonData, loadID, flags, -1)
+	}
+	result, err := client.addRecordWithInfo(ctx, "AddRecordWithInfo", err, dataSourceCode, recordID, jsonData, loadID, flags, client.stringResult(&client.AddRecordWithInfoResult))
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "jsonData", jsonData)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8002, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(4, dataSourceCode, recordID, jsonData, loadID, flags, client.AddRecordWithInfoResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(4, dataSourceCode, recordID, jsonData, loadID, flags, result, err, time.Since(entryTime))
 	}
-	return client.AddRecordWithInfoResult, err
+	return result, err
 }
 
 /*
 The AddRecordWithInfoWithReturnedRecordID method adds a record into the Senzing repository and returns information on the affected entities and the record identifier.
+In the mock, the record identifier is made as by AddRecordWithReturnedRecordID(),
+with AddRecordWithInfoWithReturnedRecordIDResultRecordID in place of AddRecordWithReturnedRecordIDResult.
+The record is then added as by AddRecordWithInfo() with that identifier, including AddRecordWithInfoFunc,
+with AddRecordWithInfoWithReturnedRecordIDResultGetWithInfo in place of AddRecordWithInfoResult.
 
 Input
   - ctx: A context to control lifecycle.
@@ -218,33 +1875,54 @@ Input
 Output
   - A JSON document containing the AFFECTED_ENTITIES, INTERESTING_ENTITIES, and RECORD_ID.
     Example: `{"DATA_SOURCE":"TEST","RECORD_ID":"2D4DABB3FAEAFBD452E9487D06FABC22DC69C846","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`
-  - The record identifier.
+  - The record identifier, empty if the record was not added.
     Example: `2D4DABB3FAEAFBD452E9487D06FABC22DC69C846`
 */
 func (client *G2engine) AddRecordWithInfoWithReturnedRecordID(ctx context.Context, dataSourceCode string, jsonData string, loadID string, flags int64) (string, string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(5, dataSourceCode, jsonData, loadID, flags)
 	}
+	client.Recorder.Record("g2engine", "AddRecordWithInfoWithReturnedRecordID", dataSourceCode, jsonData, loadID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "AddRecordWithInfoWithReturnedRecordID"); err == nil {
+		defer client.finishCall("AddRecordWithInfoWithReturnedRecordID", entryTime)
+	}
+	recordID := client.returnedRecordID(dataSourceCode, jsonData, client.stringResult(&client.AddRecordWithInfoWithReturnedRecordIDResultRecordID))
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("AddRecordWithInfoWithReturnedRecordID", map[string]string{"dataSourceCode": dataSourceCode}, 4003, dataSourceCode, jsonData, loadID, flags, -1)
+	}
+	result, err := client.addRecordWithInfo(ctx, "AddRecordWithInfoWithReturnedRecordID", err, dataSourceCode, recordID, jsonData, loadID, flags, client.stringResult(&client.AddRecordWithInfoWithReturnedRecordIDResultGetWithInfo))
+	if err != nil {
+		recordID = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
-				"recordID":       client.AddRecordWithInfoWithReturnedRecordIDResultRecordID,
+				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "jsonData", jsonData)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8003, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(6, dataSourceCode, jsonData, loadID, flags, client.AddRecordWithInfoWithReturnedRecordIDResultGetWithInfo, client.AddRecordWithInfoWithReturnedRecordIDResultRecordID, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(6, dataSourceCode, jsonData, loadID, flags, result, recordID, err, time.Since(entryTime))
 	}
-	return client.AddRecordWithInfoWithReturnedRecordIDResultGetWithInfo, client.AddRecordWithInfoWithReturnedRecordIDResultRecordID, err
+	return result, recordID, err
 }
 
 /*
 The AddRecordWithReturnedRecordID method adds a record into the Senzing repository and returns the record identifier.
+In the mock, the record identifier is the first of:
+  - The identifier made by RecordIDGenerator, if it is set.
+  - AddRecordWithReturnedRecordIDResult, if it is set.
+  - The identifier generated from the data source code and JSON data by resultbuilder.RecordID(),
+    so that each distinct record gets its own.
 
 Input
   - ctx: A context to control lifecycle.
@@ -253,29 +1931,51 @@ Input
   - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
 
 Output
-  - The record identifier.
+  - The record identifier, empty if the record was not added.
     Example: `2D4DABB3FAEAFBD452E9487D06FABC22DC69C846`
 */
 func (client *G2engine) AddRecordWithReturnedRecordID(ctx context.Context, dataSourceCode string, jsonData string, loadID string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(7, dataSourceCode, jsonData, loadID)
 	}
+	client.Recorder.Record("g2engine", "AddRecordWithReturnedRecordID", dataSourceCode, jsonData, loadID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "AddRecordWithReturnedRecordID"); err == nil {
+		defer client.finishCall("AddRecordWithReturnedRecordID", entryTime)
+	}
+	recordID := client.returnedRecordID(dataSourceCode, jsonData, client.stringResult(&client.AddRecordWithReturnedRecordIDResult))
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("AddRecordWithReturnedRecordID", map[string]string{"dataSourceCode": dataSourceCode}, 4004, dataSourceCode, jsonData, loadID, -1)
+	}
+	if err == nil && client.callPolicies.fail("AddRecordWithReturnedRecordID", dataSourceCode, recordID) {
+		err = client.newError("AddRecordWithReturnedRecordID", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "AddRecordWithReturnedRecordID", dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.trackReplication(dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.storeRecord(dataSourceCode, recordID, jsonData, loadID)
+	} else {
+		recordID = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
-				"recordID":       client.AddRecordWithReturnedRecordIDResult,
+				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "jsonData", jsonData)
 			client.notify(ctx, 8004, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(8, dataSourceCode, jsonData, loadID, client.AddRecordWithReturnedRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(8, dataSourceCode, jsonData, loadID, recordID, err, time.Since(entryTime))
 	}
-	return client.AddRecordWithReturnedRecordIDResult, err
+	return recordID, err
 }
 
 /*
@@ -292,45 +1992,71 @@ Output
     See the example output.
 */
 func (client *G2engine) CheckRecord(ctx context.Context, record string, recordQueryList string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(9, record, recordQueryList)
 	}
+	client.Recorder.Record("g2engine", "CheckRecord", record, recordQueryList)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "CheckRecord"); err == nil {
+		defer client.finishCall("CheckRecord", entryTime)
+	}
+	result := client.stringResult(&client.CheckRecordResult)
+	if err == nil && client.CheckRecordFunc != nil {
+		result, err = client.CheckRecordFunc(ctx, record, recordQueryList)
+	}
+	result = client.mockMetadata("CheckRecord", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", record)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8005, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(10, record, recordQueryList, client.CheckRecordResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(10, record, recordQueryList, result, err, time.Since(entryTime))
 	}
-	return client.CheckRecordResult, err
+	return result, err
 }
 
 /*
 The CloseExport method closes the exported document created by ExportJSONEntityReport().
 It is part of the ExportJSONEntityReport(), FetchNext(), CloseExport()
 lifecycle of a list of sized entities.
+In the mock, closing the handle of an export of ExportPages again is an error.
 
 Input
   - ctx: A context to control lifecycle.
   - responseHandle: A handle created by ExportJSONEntityReport() or ExportCSVEntityReport().
 */
 func (client *G2engine) CloseExport(ctx context.Context, responseHandle uintptr) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(13, responseHandle)
 	}
+	client.Recorder.Record("g2engine", "CloseExport", responseHandle)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "CloseExport"); err == nil {
+		defer client.finishCall("CloseExport", entryTime)
+	}
+	if err == nil && client.exports.isClosed(responseHandle) {
+		err = client.newError("CloseExport", map[string]string{"responseHandle": strconv.FormatUint(uint64(responseHandle), 10)}, 4917, responseHandle)
+	}
+	if err == nil && !client.handles.valid(responseHandle) {
+		err = client.newError("CloseExport", map[string]string{"responseHandle": strconv.FormatUint(uint64(responseHandle), 10)}, 4910, responseHandle)
+	}
+	if err == nil {
+		client.handles.close(responseHandle)
+		client.exports.close(responseHandle)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8006, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(14, responseHandle, err, time.Since(entryTime))
 	}
 	return err
@@ -338,6 +2064,8 @@ func (client *G2engine) CloseExport(ctx context.Context, responseHandle uintptr)
 
 /*
 The CountRedoRecords method returns the number of records in need of redo-ing.
+In the mock, once PushRedoRecord() has been called, it is the number of records in the redo queue.
+Otherwise it is CountRedoRecordsResult.
 
 Input
   - ctx: A context to control lifecycle.
@@ -346,25 +2074,31 @@ Output
   - The number of redo records in Senzing's redo queue.
 */
 func (client *G2engine) CountRedoRecords(ctx context.Context) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(15)
 	}
+	client.Recorder.Record("g2engine", "CountRedoRecords")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "CountRedoRecords"); err == nil {
+		defer client.finishCall("CountRedoRecords", entryTime)
+	}
+	result := client.RedoRecordCount()
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8007, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(16, client.CountRedoRecordsResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(16, result, err, time.Since(entryTime))
 	}
-	return client.CountRedoRecordsResult, err
+	return result, err
 }
 
 /*
 The DeleteRecord method deletes a record from the Senzing repository.
+In the mock, if Stateful is set, deleting a record that is not in the repository is an error.
 
 Input
   - ctx: A context to control lifecycle.
@@ -374,22 +2108,40 @@ Input
     FIXME: How does the "loadID" affect what is deleted?
 */
 func (client *G2engine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, loadID string) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(17, dataSourceCode, recordID, loadID)
 	}
+	client.Recorder.Record("g2engine", "DeleteRecord", dataSourceCode, recordID, loadID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "DeleteRecord"); err == nil {
+		defer client.finishCall("DeleteRecord", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("DeleteRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4007, dataSourceCode, recordID, loadID, -1)
+	}
+	if err == nil && client.callPolicies.fail("DeleteRecord", dataSourceCode, recordID) {
+		err = client.newError("DeleteRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "DeleteRecord", dataSourceCode, recordID)
+	}
+	if err == nil {
+		err = client.checkStored("DeleteRecord", dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.removeRecord(dataSourceCode, recordID)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 				"loadID":         loadID,
 			}
 			client.notify(ctx, 8008, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(18, dataSourceCode, recordID, loadID, err, time.Since(entryTime))
 	}
 	return err
@@ -397,6 +2149,8 @@ func (client *G2engine) DeleteRecord(ctx context.Context, dataSourceCode string,
 
 /*
 The DeleteRecordWithInfo method deletes a record from the Senzing repository and returns information on the affected entities.
+In the mock, if Stateful is set, deleting a record that is not in the repository is an error.
+If DeleteRecordWithInfoResult is empty, the result lists the entity the record resolved to.
 
 Input
   - ctx: A context to control lifecycle.
@@ -411,25 +2165,58 @@ Output
     See the example output.
 */
 func (client *G2engine) DeleteRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, loadID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(19, dataSourceCode, recordID, loadID, flags)
 	}
-	var err error = nil
-	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.Recorder.Record("g2engine", "DeleteRecordWithInfo", dataSourceCode, recordID, loadID, flags)
+	var err error = nil
+	entryTime := time.Now()
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "DeleteRecordWithInfo"); err == nil {
+		defer client.finishCall("DeleteRecordWithInfo", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("DeleteRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4008, dataSourceCode, recordID, loadID, flags, -1)
+	}
+	if err == nil && client.callPolicies.fail("DeleteRecordWithInfo", dataSourceCode, recordID) {
+		err = client.newError("DeleteRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "DeleteRecordWithInfo", dataSourceCode, recordID)
+	}
+	if err == nil {
+		err = client.checkStored("DeleteRecordWithInfo", dataSourceCode, recordID)
+	}
+	var removed storedRecord
+	if err == nil {
+		removed = client.removeRecord(dataSourceCode, recordID)
+	}
+	result := ""
+	if err == nil {
+		result = client.withInfoResult(dataSourceCode, recordID, client.stringResult(&client.DeleteRecordWithInfoResult))
+	}
+	if err == nil && client.Stateful && len(result) == 0 {
+		result = client.storedWithInfo(dataSourceCode, recordID, removed.entityID)
+	}
+	if err == nil && client.DeleteRecordWithInfoFunc != nil {
+		result, err = client.DeleteRecordWithInfoFunc(ctx, dataSourceCode, recordID, loadID, flags)
+	}
+	if err == nil {
+		result = client.mockMetadata("DeleteRecordWithInfo", result)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8009, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(20, dataSourceCode, recordID, loadID, flags, client.DeleteRecordWithInfoResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(20, dataSourceCode, recordID, loadID, flags, result, err, time.Since(entryTime))
 	}
-	return client.DeleteRecordWithInfoResult, err
+	return result, err
 }
 
 /*
@@ -440,18 +2227,23 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) Destroy(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(21)
 	}
+	client.Recorder.Record("g2engine", "Destroy")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	err = client.DestroyError
+	if err == nil {
+		client.lifecycle.transition(StateDestroyed)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8010, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(22, err, time.Since(entryTime))
 	}
 	return err
@@ -467,21 +2259,31 @@ Output
   - A JSON document containing the current Senzing Engine configuration.
 */
 func (client *G2engine) ExportConfig(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(25)
 	}
+	client.Recorder.Record("g2engine", "ExportConfig")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "ExportConfig"); err == nil {
+		defer client.finishCall("ExportConfig", entryTime)
+	}
+	result := client.stringResult(&client.ExportConfigResult)
+	if err == nil && client.ExportConfigFunc != nil {
+		result, err = client.ExportConfigFunc(ctx)
+	}
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8011, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(26, client.ExportConfigResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(26, result, err, time.Since(entryTime))
 	}
-	return client.ExportConfigResult, err
+	return result, err
 }
 
 /*
@@ -495,29 +2297,40 @@ Output
   - The unique identifier of the Senzing Engine configuration.
 */
 func (client *G2engine) ExportConfigAndConfigID(ctx context.Context) (string, int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(23)
 	}
+	client.Recorder.Record("g2engine", "ExportConfigAndConfigID")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "ExportConfigAndConfigID"); err == nil {
+		defer client.finishCall("ExportConfigAndConfigID", entryTime)
+	}
+	config := client.stringResult(&client.ExportConfigAndConfigIDResultConfig)
+	configID := client.int64Result(&client.ExportConfigAndConfigIDResultConfigID)
+	if err == nil && client.ExportConfigAndConfigIDFunc != nil {
+		config, configID, err = client.ExportConfigAndConfigIDFunc(ctx)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
-				"configID": strconv.FormatInt(client.ExportConfigAndConfigIDResultConfigID, 10),
+				"configID": strconv.FormatInt(configID, 10),
 			}
 			client.notify(ctx, 8012, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(24, client.ExportConfigAndConfigIDResultConfig, client.ExportConfigAndConfigIDResultConfigID, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(24, config, configID, err, time.Since(entryTime))
 	}
-	return client.ExportConfigAndConfigIDResultConfig, client.ExportConfigAndConfigIDResultConfigID, err
+	return config, configID, err
 }
 
 /*
 The ExportCSVEntityReport method initializes a cursor over a document of exported entities.
 It is part of the ExportCSVEntityReport(), FetchNext(), CloseExport()
 lifecycle of a list of entities to export.
+In the mock, if ExportPages is set, each call returns a new handle, bound to a copy of ExportPages.
+Otherwise it returns ExportCSVEntityReportResult.
 
 Input
   - ctx: A context to control lifecycle.
@@ -528,27 +2341,38 @@ Output
   - A handle that identifies the document to be scrolled through using FetchNext().
 */
 func (client *G2engine) ExportCSVEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(27, csvColumnList, flags)
 	}
+	client.Recorder.Record("g2engine", "ExportCSVEntityReport", csvColumnList, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "ExportCSVEntityReport"); err == nil {
+		defer client.finishCall("ExportCSVEntityReport", entryTime)
+	}
+	result := client.uintptrResult(&client.ExportCSVEntityReportResult)
+	if err == nil {
+		result = client.startExport(result)
+		client.handles.issue(result)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8013, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(28, csvColumnList, flags, client.ExportCSVEntityReportResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(28, csvColumnList, flags, result, err, time.Since(entryTime))
 	}
-	return client.ExportCSVEntityReportResult, err
+	return result, err
 }
 
 /*
 The ExportJSONEntityReport method initializes a cursor over a document of exported entities.
 It is part of the ExportJSONEntityReport(), FetchNext(), CloseExport()
 lifecycle of a list of entities to export.
+In the mock, if ExportPages is set, each call returns a new handle, bound to a copy of ExportPages.
+Otherwise it returns ExportJSONEntityReportResult.
 
 Input
   - ctx: A context to control lifecycle.
@@ -558,21 +2382,30 @@ Output
   - A handle that identifies the document to be scrolled through using FetchNext().
 */
 func (client *G2engine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(29, flags)
 	}
+	client.Recorder.Record("g2engine", "ExportJSONEntityReport", flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "ExportJSONEntityReport"); err == nil {
+		defer client.finishCall("ExportJSONEntityReport", entryTime)
+	}
+	result := client.uintptrResult(&client.ExportJSONEntityReportResult)
+	if err == nil {
+		result = client.startExport(result)
+		client.handles.issue(result)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8014, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(30, flags, client.ExportJSONEntityReportResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(30, flags, result, err, time.Since(entryTime))
 	}
-	return client.ExportJSONEntityReportResult, err
+	return result, err
 }
 
 /*
@@ -580,6 +2413,10 @@ The FetchNext method is used to scroll through an exported document.
 It is part of the ExportJSONEntityReport() or ExportCSVEntityReport(), FetchNext(), CloseExport()
 lifecycle of a list of exported entities.
 
+In the mock, handles of exports started while ExportPages is set return the next page, then an empty string.
+For other handles, results queued with EnqueueFetchNextResult() are returned first, then FetchNextResult.
+Handles closed by CloseExport() are an error.
+
 Input
   - ctx: A context to control lifecycle.
   - responseHandle: A handle created by ExportJSONEntityReport() or ExportCSVEntityReport().
@@ -588,21 +2425,47 @@ Output
   - FIXME:
 */
 func (client *G2engine) FetchNext(ctx context.Context, responseHandle uintptr) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(31, responseHandle)
 	}
+	client.Recorder.Record("g2engine", "FetchNext", responseHandle)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FetchNext"); err == nil {
+		defer client.finishCall("FetchNext", entryTime)
+	}
+	if err == nil && client.exports.isClosed(responseHandle) {
+		err = client.newError("FetchNext", map[string]string{"responseHandle": strconv.FormatUint(uint64(responseHandle), 10)}, 4917, responseHandle)
+	}
+	if err == nil {
+		err = client.checkHandle(responseHandle)
+	}
+	if err == nil {
+		client.handles.use(responseHandle)
+	}
+	result := client.stringResult(&client.FetchNextResult)
+	if err == nil {
+		if page, ok := client.exports.fetch(responseHandle); ok {
+			result = page
+		} else if queued, ok := client.resultQueues.pop("FetchNext"); ok {
+			result = queued
+		}
+	}
+	if err == nil && client.FetchNextFunc != nil {
+		result, err = client.FetchNextFunc(ctx, responseHandle)
+	}
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8015, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(32, responseHandle, client.FetchNextResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(32, responseHandle, result, err, time.Since(entryTime))
 	}
-	return client.FetchNextResult, err
+	return result, err
 }
 
 /*
@@ -618,23 +2481,36 @@ Output
     See the example output.
 */
 func (client *G2engine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(33, entityID, flags)
 	}
+	client.Recorder.Record("g2engine", "FindInterestingEntitiesByEntityID", entityID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindInterestingEntitiesByEntityID"); err == nil {
+		defer client.finishCall("FindInterestingEntitiesByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindInterestingEntitiesByEntityID", entityID)
+	}
+	result := client.interestingEntitiesByEntityIDResult(entityID, client.stringResult(&client.FindInterestingEntitiesByEntityIDResult))
+	if err == nil && client.FindInterestingEntitiesByEntityIDFunc != nil {
+		result, err = client.FindInterestingEntitiesByEntityIDFunc(ctx, entityID, flags)
+	}
+	result = client.mockMetadata("FindInterestingEntitiesByEntityID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8016, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(34, entityID, flags, client.FindInterestingEntitiesByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(34, entityID, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindInterestingEntitiesByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -651,24 +2527,40 @@ Output
     See the example output.
 */
 func (client *G2engine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(35, dataSourceCode, recordID, flags)
 	}
+	client.Recorder.Record("g2engine", "FindInterestingEntitiesByRecordID", dataSourceCode, recordID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindInterestingEntitiesByRecordID"); err == nil {
+		defer client.finishCall("FindInterestingEntitiesByRecordID", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindInterestingEntitiesByRecordID", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("FindInterestingEntitiesByRecordID", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4016, dataSourceCode, recordID, flags, -1)
+	}
+	result := client.interestingEntitiesResult(dataSourceCode, recordID, client.stringResult(&client.FindInterestingEntitiesByRecordIDResult))
+	if err == nil && client.FindInterestingEntitiesByRecordIDFunc != nil {
+		result, err = client.FindInterestingEntitiesByRecordIDFunc(ctx, dataSourceCode, recordID, flags)
+	}
+	result = client.mockMetadata("FindInterestingEntitiesByRecordID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8017, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(36, dataSourceCode, recordID, flags, client.FindInterestingEntitiesByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(36, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindInterestingEntitiesByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -689,23 +2581,34 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"SEAMAN","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-11-29 22:25:18.997","LAST_SEEN_DT":"2022-11-29 22:25:19.005"}],"LAST_SEEN_DT":"2022-11-29 22:25:19.005"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-11-29 22:25:19.009","LAST_SEEN_DT":"2022-11-29 22:25:19.009"}],"LAST_SEEN_DT":"2022-11-29 22:25:19.009"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindNetworkByEntityID(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(37, entityList, maxDegree, buildOutDegree, maxDegree)
 	}
+	client.Recorder.Record("g2engine", "FindNetworkByEntityID", entityList, maxDegree, buildOutDegree, maxEntities)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindNetworkByEntityID"); err == nil {
+		defer client.finishCall("FindNetworkByEntityID", entryTime)
+	}
+	result := client.stringResult(&client.FindNetworkByEntityIDResult)
+	if err == nil && client.FindNetworkByEntityIDFunc != nil {
+		result, err = client.FindNetworkByEntityIDFunc(ctx, entityList, maxDegree, buildOutDegree, maxEntities)
+	}
+	result = client.mockMetadata("FindNetworkByEntityID", result)
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityList": entityList,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8018, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(38, entityList, maxDegree, buildOutDegree, maxDegree, client.FindNetworkByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(38, entityList, maxDegree, buildOutDegree, maxDegree, result, err, time.Since(entryTime))
 	}
-	return client.FindNetworkByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -727,23 +2630,38 @@ Output
     See the example output.
 */
 func (client *G2engine) FindNetworkByEntityID_V2(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(39, entityList, maxDegree, buildOutDegree, maxDegree, flags)
 	}
+	client.Recorder.Record("g2engine", "FindNetworkByEntityID_V2", entityList, maxDegree, buildOutDegree, maxEntities, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindNetworkByEntityID_V2"); err == nil {
+		defer client.finishCall("FindNetworkByEntityID_V2", entryTime)
+	}
+	client.recordFlags("FindNetworkByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindNetworkByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.FindNetworkByEntityID_V2Result)
+	if err == nil && client.FindNetworkByEntityID_V2Func != nil {
+		result, err = client.FindNetworkByEntityID_V2Func(ctx, entityList, maxDegree, buildOutDegree, maxEntities, flags)
+	}
+	result = client.mockMetadata("FindNetworkByEntityID_V2", result)
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityList": entityList,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8019, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(40, entityList, maxDegree, buildOutDegree, maxDegree, flags, client.FindNetworkByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(40, entityList, maxDegree, buildOutDegree, maxDegree, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindNetworkByEntityID_V2Result, err
+	return result, err
 }
 
 /*
@@ -764,23 +2682,34 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:40:34.285","LAST_SEEN_DT":"2022-12-06 14:40:34.420"}],"LAST_SEEN_DT":"2022-12-06 14:40:34.420"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:40:34.359","LAST_SEEN_DT":"2022-12-06 14:40:34.359"}],"LAST_SEEN_DT":"2022-12-06 14:40:34.359"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":3,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:40:34.424","LAST_SEEN_DT":"2022-12-06 14:40:34.424"}],"LAST_SEEN_DT":"2022-12-06 14:40:34.424"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindNetworkByRecordID(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(41, recordList, maxDegree, buildOutDegree, maxDegree)
 	}
+	client.Recorder.Record("g2engine", "FindNetworkByRecordID", recordList, maxDegree, buildOutDegree, maxEntities)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindNetworkByRecordID"); err == nil {
+		defer client.finishCall("FindNetworkByRecordID", entryTime)
+	}
+	result := client.stringResult(&client.FindNetworkByRecordIDResult)
+	if err == nil && client.FindNetworkByRecordIDFunc != nil {
+		result, err = client.FindNetworkByRecordIDFunc(ctx, recordList, maxDegree, buildOutDegree, maxEntities)
+	}
+	result = client.mockMetadata("FindNetworkByRecordID", result)
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"recordList": recordList,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8020, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(42, recordList, maxDegree, buildOutDegree, maxDegree, client.FindNetworkByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(42, recordList, maxDegree, buildOutDegree, maxDegree, result, err, time.Since(entryTime))
 	}
-	return client.FindNetworkByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -802,23 +2731,38 @@ Output
     See the example output.
 */
 func (client *G2engine) FindNetworkByRecordID_V2(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(43, recordList, maxDegree, buildOutDegree, maxDegree, flags)
 	}
+	client.Recorder.Record("g2engine", "FindNetworkByRecordID_V2", recordList, maxDegree, buildOutDegree, maxEntities, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindNetworkByRecordID_V2"); err == nil {
+		defer client.finishCall("FindNetworkByRecordID_V2", entryTime)
+	}
+	client.recordFlags("FindNetworkByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindNetworkByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.FindNetworkByRecordID_V2Result)
+	if err == nil && client.FindNetworkByRecordID_V2Func != nil {
+		result, err = client.FindNetworkByRecordID_V2Func(ctx, recordList, maxDegree, buildOutDegree, maxEntities, flags)
+	}
+	result = client.mockMetadata("FindNetworkByRecordID_V2", result)
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"recordList": recordList,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8021, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(44, recordList, maxDegree, buildOutDegree, maxDegree, flags, client.FindNetworkByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(44, recordList, maxDegree, buildOutDegree, maxDegree, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindNetworkByRecordID_V2Result, err
+	return result, err
 }
 
 /*
@@ -837,24 +2781,40 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:43:49.024","LAST_SEEN_DT":"2022-12-06 14:43:49.164"}],"LAST_SEEN_DT":"2022-12-06 14:43:49.164"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:43:49.104","LAST_SEEN_DT":"2022-12-06 14:43:49.104"}],"LAST_SEEN_DT":"2022-12-06 14:43:49.104"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(45, entityID1, entityID2, maxDegree)
 	}
+	client.Recorder.Record("g2engine", "FindPathByEntityID", entityID1, entityID2, maxDegree)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathByEntityID"); err == nil {
+		defer client.finishCall("FindPathByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindPathByEntityID", entityID1, entityID2)
+	}
+	result := client.stringResult(&client.FindPathByEntityIDResult)
+	if path, ok := client.simulatePath(entityID1, entityID2, maxDegree, nil, nil, 0); ok {
+		result = path
+	}
+	if err == nil && client.FindPathByEntityIDFunc != nil {
+		result, err = client.FindPathByEntityIDFunc(ctx, entityID1, entityID2, maxDegree)
+	}
+	result = client.mockMetadata("FindPathByEntityID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8022, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(46, entityID1, entityID2, maxDegree, client.FindPathByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(46, entityID1, entityID2, maxDegree, result, err, time.Since(entryTime))
 	}
-	return client.FindPathByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -874,24 +2834,44 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(47, entityID1, entityID2, maxDegree, flags)
 	}
+	client.Recorder.Record("g2engine", "FindPathByEntityID_V2", entityID1, entityID2, maxDegree, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathByEntityID_V2"); err == nil {
+		defer client.finishCall("FindPathByEntityID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindPathByEntityID_V2", entityID1, entityID2)
+	}
+	client.recordFlags("FindPathByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindPathByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.FindPathByEntityID_V2Result)
+	if path, ok := client.simulatePath(entityID1, entityID2, maxDegree, nil, nil, flags); ok {
+		result = path
+	}
+	if err == nil && client.FindPathByEntityID_V2Func != nil {
+		result, err = client.FindPathByEntityID_V2Func(ctx, entityID1, entityID2, maxDegree, flags)
+	}
+	result = client.mockMetadata("FindPathByEntityID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8023, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(48, entityID1, entityID2, maxDegree, flags, client.FindPathByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(48, entityID1, entityID2, maxDegree, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindPathByEntityID_V2Result, err
+	return result, err
 }
 
 /*
@@ -914,26 +2894,42 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:48:19.522","LAST_SEEN_DT":"2022-12-06 14:48:19.667"}],"LAST_SEEN_DT":"2022-12-06 14:48:19.667"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:48:19.593","LAST_SEEN_DT":"2022-12-06 14:48:19.593"}],"LAST_SEEN_DT":"2022-12-06 14:48:19.593"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(49, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree)
 	}
+	client.Recorder.Record("g2engine", "FindPathByRecordID", dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathByRecordID"); err == nil {
+		defer client.finishCall("FindPathByRecordID", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindPathByRecordID", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	result := client.stringResult(&client.FindPathByRecordIDResult)
+	if path, ok := client.simulatePathByRecordID(dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, "", nil, 0); ok {
+		result = path
+	}
+	if err == nil && client.FindPathByRecordIDFunc != nil {
+		result, err = client.FindPathByRecordIDFunc(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree)
+	}
+	result = client.mockMetadata("FindPathByRecordID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8024, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(50, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, client.FindPathByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(50, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, result, err, time.Since(entryTime))
 	}
-	return client.FindPathByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -956,26 +2952,46 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(51, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
 	}
+	client.Recorder.Record("g2engine", "FindPathByRecordID_V2", dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathByRecordID_V2"); err == nil {
+		defer client.finishCall("FindPathByRecordID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindPathByRecordID_V2", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	client.recordFlags("FindPathByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindPathByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.FindPathByRecordID_V2Result)
+	if path, ok := client.simulatePathByRecordID(dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, "", nil, flags); ok {
+		result = path
+	}
+	if err == nil && client.FindPathByRecordID_V2Func != nil {
+		result, err = client.FindPathByRecordID_V2Func(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
+	}
+	result = client.mockMetadata("FindPathByRecordID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8025, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(52, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags, client.FindPathByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(52, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindPathByRecordID_V2Result, err
+	return result, err
 }
 
 /*
@@ -996,24 +3012,40 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:50:49.222","LAST_SEEN_DT":"2022-12-06 14:50:49.356"}],"LAST_SEEN_DT":"2022-12-06 14:50:49.356"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:50:49.295","LAST_SEEN_DT":"2022-12-06 14:50:49.295"}],"LAST_SEEN_DT":"2022-12-06 14:50:49.295"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathExcludingByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(53, entityID1, entityID2, maxDegree, excludedEntities)
 	}
+	client.Recorder.Record("g2engine", "FindPathExcludingByEntityID", entityID1, entityID2, maxDegree, excludedEntities)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathExcludingByEntityID"); err == nil {
+		defer client.finishCall("FindPathExcludingByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindPathExcludingByEntityID", entityID1, entityID2)
+	}
+	result := client.stringResult(&client.FindPathExcludingByEntityIDResult)
+	if path, ok := client.simulatePath(entityID1, entityID2, maxDegree, parseExcludedEntities(excludedEntities), nil, 0); ok {
+		result = path
+	}
+	if err == nil && client.FindPathExcludingByEntityIDFunc != nil {
+		result, err = client.FindPathExcludingByEntityIDFunc(ctx, entityID1, entityID2, maxDegree, excludedEntities)
+	}
+	result = client.mockMetadata("FindPathExcludingByEntityID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8026, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(54, entityID1, entityID2, maxDegree, excludedEntities, client.FindPathExcludingByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(54, entityID1, entityID2, maxDegree, excludedEntities, result, err, time.Since(entryTime))
 	}
-	return client.FindPathExcludingByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -1040,24 +3072,44 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathExcludingByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(55, entityID1, entityID2, maxDegree, excludedEntities, flags)
 	}
+	client.Recorder.Record("g2engine", "FindPathExcludingByEntityID_V2", entityID1, entityID2, maxDegree, excludedEntities, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathExcludingByEntityID_V2"); err == nil {
+		defer client.finishCall("FindPathExcludingByEntityID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindPathExcludingByEntityID_V2", entityID1, entityID2)
+	}
+	client.recordFlags("FindPathExcludingByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindPathExcludingByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.FindPathExcludingByEntityID_V2Result)
+	if path, ok := client.simulatePath(entityID1, entityID2, maxDegree, parseExcludedEntities(excludedEntities), nil, flags); ok {
+		result = path
+	}
+	if err == nil && client.FindPathExcludingByEntityID_V2Func != nil {
+		result, err = client.FindPathExcludingByEntityID_V2Func(ctx, entityID1, entityID2, maxDegree, excludedEntities, flags)
+	}
+	result = client.mockMetadata("FindPathExcludingByEntityID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8027, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(56, entityID1, entityID2, maxDegree, excludedEntities, flags, client.FindPathExcludingByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(56, entityID1, entityID2, maxDegree, excludedEntities, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindPathExcludingByEntityID_V2Result, err
+	return result, err
 }
 
 /*
@@ -1080,26 +3132,42 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:55:02.577","LAST_SEEN_DT":"2022-12-06 14:55:02.711"}],"LAST_SEEN_DT":"2022-12-06 14:55:02.711"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:55:02.649","LAST_SEEN_DT":"2022-12-06 14:55:02.649"}],"LAST_SEEN_DT":"2022-12-06 14:55:02.649"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathExcludingByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(57, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords)
 	}
+	client.Recorder.Record("g2engine", "FindPathExcludingByRecordID", dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathExcludingByRecordID"); err == nil {
+		defer client.finishCall("FindPathExcludingByRecordID", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindPathExcludingByRecordID", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	result := client.stringResult(&client.FindPathExcludingByRecordIDResult)
+	if path, ok := client.simulatePathByRecordID(dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, nil, 0); ok {
+		result = path
+	}
+	if err == nil && client.FindPathExcludingByRecordIDFunc != nil {
+		result, err = client.FindPathExcludingByRecordIDFunc(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords)
+	}
+	result = client.mockMetadata("FindPathExcludingByRecordID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8028, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(58, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, client.FindPathExcludingByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(58, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, result, err, time.Since(entryTime))
 	}
-	return client.FindPathExcludingByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -1128,26 +3196,46 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathExcludingByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(59, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags)
 	}
+	client.Recorder.Record("g2engine", "FindPathExcludingByRecordID_V2", dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathExcludingByRecordID_V2"); err == nil {
+		defer client.finishCall("FindPathExcludingByRecordID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindPathExcludingByRecordID_V2", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	client.recordFlags("FindPathExcludingByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindPathExcludingByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.FindPathExcludingByRecordID_V2Result)
+	if path, ok := client.simulatePathByRecordID(dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, nil, flags); ok {
+		result = path
+	}
+	if err == nil && client.FindPathExcludingByRecordID_V2Func != nil {
+		result, err = client.FindPathExcludingByRecordID_V2Func(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags)
+	}
+	result = client.mockMetadata("FindPathExcludingByRecordID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8029, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(60, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags, client.FindPathExcludingByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(60, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindPathExcludingByRecordID_V2Result, err
+	return result, err
 }
 
 /*
@@ -1170,24 +3258,40 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:00:30.268","LAST_SEEN_DT":"2022-12-06 15:00:30.429"}],"LAST_SEEN_DT":"2022-12-06 15:00:30.429"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:00:30.339","LAST_SEEN_DT":"2022-12-06 15:00:30.339"}],"LAST_SEEN_DT":"2022-12-06 15:00:30.339"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathIncludingSourceByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(61, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs)
 	}
+	client.Recorder.Record("g2engine", "FindPathIncludingSourceByEntityID", entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathIncludingSourceByEntityID"); err == nil {
+		defer client.finishCall("FindPathIncludingSourceByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindPathIncludingSourceByEntityID", entityID1, entityID2)
+	}
+	result := client.stringResult(&client.FindPathIncludingSourceByEntityIDResult)
+	if path, ok := client.simulatePath(entityID1, entityID2, maxDegree, parseExcludedEntities(excludedEntities), parseRequiredDsrcs(requiredDsrcs), 0); ok {
+		result = path
+	}
+	if err == nil && client.FindPathIncludingSourceByEntityIDFunc != nil {
+		result, err = client.FindPathIncludingSourceByEntityIDFunc(ctx, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs)
+	}
+	result = client.mockMetadata("FindPathIncludingSourceByEntityID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8030, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(62, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, client.FindPathIncludingSourceByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(62, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, result, err, time.Since(entryTime))
 	}
-	return client.FindPathIncludingSourceByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -1211,24 +3315,44 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathIncludingSourceByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(63, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags)
 	}
+	client.Recorder.Record("g2engine", "FindPathIncludingSourceByEntityID_V2", entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathIncludingSourceByEntityID_V2"); err == nil {
+		defer client.finishCall("FindPathIncludingSourceByEntityID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("FindPathIncludingSourceByEntityID_V2", entityID1, entityID2)
+	}
+	client.recordFlags("FindPathIncludingSourceByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindPathIncludingSourceByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.FindPathIncludingSourceByEntityID_V2Result)
+	if path, ok := client.simulatePath(entityID1, entityID2, maxDegree, parseExcludedEntities(excludedEntities), parseRequiredDsrcs(requiredDsrcs), flags); ok {
+		result = path
+	}
+	if err == nil && client.FindPathIncludingSourceByEntityID_V2Func != nil {
+		result, err = client.FindPathIncludingSourceByEntityID_V2Func(ctx, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags)
+	}
+	result = client.mockMetadata("FindPathIncludingSourceByEntityID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8031, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(64, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags, client.FindPathIncludingSourceByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(64, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindPathIncludingSourceByEntityID_V2Result, err
+	return result, err
 }
 
 /*
@@ -1253,26 +3377,42 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:03:52.805","LAST_SEEN_DT":"2022-12-06 15:03:52.947"}],"LAST_SEEN_DT":"2022-12-06 15:03:52.947"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:03:52.876","LAST_SEEN_DT":"2022-12-06 15:03:52.876"}],"LAST_SEEN_DT":"2022-12-06 15:03:52.876"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathIncludingSourceByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(65, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs)
 	}
+	client.Recorder.Record("g2engine", "FindPathIncludingSourceByRecordID", dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathIncludingSourceByRecordID"); err == nil {
+		defer client.finishCall("FindPathIncludingSourceByRecordID", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindPathIncludingSourceByRecordID", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	result := client.stringResult(&client.FindPathIncludingSourceByRecordIDResult)
+	if path, ok := client.simulatePathByRecordID(dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, parseRequiredDsrcs(requiredDsrcs), 0); ok {
+		result = path
+	}
+	if err == nil && client.FindPathIncludingSourceByRecordIDFunc != nil {
+		result, err = client.FindPathIncludingSourceByRecordIDFunc(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs)
+	}
+	result = client.mockMetadata("FindPathIncludingSourceByRecordID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8032, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(66, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, client.FindPathIncludingSourceByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(66, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, result, err, time.Since(entryTime))
 	}
-	return client.FindPathIncludingSourceByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -1298,26 +3438,46 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathIncludingSourceByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(67, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags)
 	}
+	client.Recorder.Record("g2engine", "FindPathIncludingSourceByRecordID_V2", dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "FindPathIncludingSourceByRecordID_V2"); err == nil {
+		defer client.finishCall("FindPathIncludingSourceByRecordID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("FindPathIncludingSourceByRecordID_V2", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	client.recordFlags("FindPathIncludingSourceByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("FindPathIncludingSourceByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.FindPathIncludingSourceByRecordID_V2Result)
+	if path, ok := client.simulatePathByRecordID(dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, parseRequiredDsrcs(requiredDsrcs), flags); ok {
+		result = path
+	}
+	if err == nil && client.FindPathIncludingSourceByRecordID_V2Func != nil {
+		result, err = client.FindPathIncludingSourceByRecordID_V2Func(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags)
+	}
+	result = client.mockMetadata("FindPathIncludingSourceByRecordID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8033, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(68, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags, client.FindPathIncludingSourceByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(68, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags, result, err, time.Since(entryTime))
 	}
-	return client.FindPathIncludingSourceByRecordID_V2Result, err
+	return result, err
 }
 
 /*
@@ -1330,26 +3490,32 @@ Output
   - The identifier of the active Senzing Engine configuration.
 */
 func (client *G2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(69)
 	}
+	client.Recorder.Record("g2engine", "GetActiveConfigID")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetActiveConfigID"); err == nil {
+		defer client.finishCall("GetActiveConfigID", entryTime)
+	}
+	result := client.int64Result(&client.GetActiveConfigIDResult)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8034, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(70, client.GetActiveConfigIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(70, result, err, time.Since(entryTime))
 	}
-	return client.GetActiveConfigIDResult, err
+	return result, err
 }
 
 /*
 The GetEntityByEntityID method returns entity data based on the ID of a resolved identity.
 To control output, use GetEntityByEntityID_V2() instead.
+In the mock, if GetEntityByEntityIDResult is empty, entities of the Stateful repository are built from their records.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1361,28 +3527,49 @@ Output
     Example: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:09:48.577","LAST_SEEN_DT":"2022-12-06 15:09:48.705"}],"LAST_SEEN_DT":"2022-12-06 15:09:48.705","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:09:48.577"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+EXACTLY_SAME","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:09:48.705"}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:09:48.647","LAST_SEEN_DT":"2022-12-06 15:09:48.647"}],"LAST_SEEN_DT":"2022-12-06 15:09:48.647"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:09:48.709","LAST_SEEN_DT":"2022-12-06 15:09:48.709"}],"LAST_SEEN_DT":"2022-12-06 15:09:48.709"}]}`
 */
 func (client *G2engine) GetEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(71, entityID)
 	}
+	client.Recorder.Record("g2engine", "GetEntityByEntityID", entityID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetEntityByEntityID"); err == nil {
+		defer client.finishCall("GetEntityByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("GetEntityByEntityID", entityID)
+	}
+	result := client.stringResult(&client.GetEntityByEntityIDResult)
+	if stored, ok := client.storedEntity(entityID); ok && len(result) == 0 {
+		result = stored
+	}
+	if err == nil && client.GetEntityByEntityIDFunc != nil {
+		result, err = client.GetEntityByEntityIDFunc(ctx, entityID)
+	}
+	result = client.mockMetadata("GetEntityByEntityID", result)
+	result = client.compress(result)
+	if err != nil {
+		result = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8035, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(72, entityID, client.GetEntityByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(72, entityID, result, err, time.Since(entryTime))
 	}
-	return client.GetEntityByEntityIDResult, err
+	return result, err
 }
 
 /*
 The GetEntityByEntityID_V2 method returns entity data based on the ID of a resolved identity.
 It extends GetEntityByEntityID() by adding output control flags.
+In the mock, if GetEntityByEntityID_V2Result is empty, entities of the Stateful repository are built from their records.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1394,28 +3581,56 @@ Output
     See the example output.
 */
 func (client *G2engine) GetEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(73, entityID, flags)
 	}
+	client.Recorder.Record("g2engine", "GetEntityByEntityID_V2", entityID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetEntityByEntityID_V2"); err == nil {
+		defer client.finishCall("GetEntityByEntityID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("GetEntityByEntityID_V2", entityID)
+	}
+	client.recordFlags("GetEntityByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("GetEntityByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.GetEntityByEntityID_V2Result)
+	if stored, ok := client.storedEntity(entityID); ok && len(result) == 0 {
+		result = stored
+	}
+	if err == nil && client.GetEntityByEntityID_V2Func != nil {
+		result, err = client.GetEntityByEntityID_V2Func(ctx, entityID, flags)
+	}
+	result = client.mockMetadata("GetEntityByEntityID_V2", result)
+	result = client.compress(result)
+	if err != nil {
+		result = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8036, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(74, entityID, flags, client.GetEntityByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(74, entityID, flags, result, err, time.Since(entryTime))
 	}
-	return client.GetEntityByEntityID_V2Result, err
+	return result, err
 }
 
 /*
 The GetEntityByRecordID method returns entity data based on the ID of a record which is a member of the entity.
 To control output, use GetEntityByRecordID_V2() instead.
+In the mock, if GetEntityByRecordIDResult is empty, entities of the Stateful repository are built from their records.
+Record IDs set with SetRecordAlias() are looked up under their current record ID.
+Results set with SetEntityByRecordIDResult() take precedence.
+If Stateful is set, a record that is not in the repository and has no such result is an error.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1427,29 +3642,72 @@ Output
     Example: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:12:25.464","LAST_SEEN_DT":"2022-12-06 15:12:25.597"}],"LAST_SEEN_DT":"2022-12-06 15:12:25.597","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:12:25.464"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+EXACTLY_SAME","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:12:25.597"}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:12:25.536","LAST_SEEN_DT":"2022-12-06 15:12:25.536"}],"LAST_SEEN_DT":"2022-12-06 15:12:25.536"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:12:25.603","LAST_SEEN_DT":"2022-12-06 15:12:25.603"}],"LAST_SEEN_DT":"2022-12-06 15:12:25.603"}]}`
 */
 func (client *G2engine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(75, dataSourceCode, recordID)
 	}
+	client.Recorder.Record("g2engine", "GetEntityByRecordID", dataSourceCode, recordID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetEntityByRecordID"); err == nil {
+		defer client.finishCall("GetEntityByRecordID", entryTime)
+	}
+	if err == nil {
+		err = client.checkDataSourceAccess("GetEntityByRecordID", dataSourceCode)
+	}
+	if err == nil {
+		recordID, err = client.resolveAlias("GetEntityByRecordID", dataSourceCode, recordID)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("GetEntityByRecordID", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("GetEntityByRecordID", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4036, dataSourceCode, recordID, -1)
+	}
+	if err == nil && !client.isReplicated(dataSourceCode, recordID) {
+		err = client.newError("GetEntityByRecordID", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4902, dataSourceCode, recordID)
+	}
+	result := client.stringResult(&client.GetEntityByRecordIDResult)
+	if stored, ok := client.storedEntityByRecordID(dataSourceCode, recordID); ok && len(result) == 0 {
+		result = stored
+	}
+	keyed, ok := client.keyedResults.get("GetEntityByRecordID", dataSourceCode, recordID)
+	if ok {
+		result = keyed
+	}
+	if err == nil && !ok {
+		err = client.checkStored("GetEntityByRecordID", dataSourceCode, recordID)
+	}
+	if err == nil && client.GetEntityByRecordIDFunc != nil {
+		result, err = client.GetEntityByRecordIDFunc(ctx, dataSourceCode, recordID)
+	}
+	result = client.mockMetadata("GetEntityByRecordID", result)
+	result = client.compress(result)
+	if err != nil {
+		result = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8037, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(76, dataSourceCode, recordID, client.GetEntityByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(76, dataSourceCode, recordID, result, err, time.Since(entryTime))
 	}
-	return client.GetEntityByRecordIDResult, err
+	return result, err
 }
 
 /*
 The GetEntityByRecordID_V2 method returns entity data based on the ID of a record which is a member of the entity.
 It extends GetEntityByRecordID() by adding output control flags.
+In the mock, if GetEntityByRecordID_V2Result is empty, entities of the Stateful repository are built from their records.
+Record IDs set with SetRecordAlias() are looked up under their current record ID.
+Results set with SetEntityByRecordIDResult() take precedence.
+If Stateful is set, a record that is not in the repository and has no such result is an error.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1462,29 +3720,75 @@ Output
     See the example output.
 */
 func (client *G2engine) GetEntityByRecordID_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(77, dataSourceCode, recordID, flags)
 	}
+	client.Recorder.Record("g2engine", "GetEntityByRecordID_V2", dataSourceCode, recordID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetEntityByRecordID_V2"); err == nil {
+		defer client.finishCall("GetEntityByRecordID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkDataSourceAccess("GetEntityByRecordID_V2", dataSourceCode)
+	}
+	if err == nil {
+		recordID, err = client.resolveAlias("GetEntityByRecordID_V2", dataSourceCode, recordID)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("GetEntityByRecordID_V2", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("GetEntityByRecordID_V2", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4037, dataSourceCode, recordID, flags, -1)
+	}
+	if err == nil && !client.isReplicated(dataSourceCode, recordID) {
+		err = client.newError("GetEntityByRecordID_V2", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4902, dataSourceCode, recordID)
+	}
+	client.recordFlags("GetEntityByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("GetEntityByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.GetEntityByRecordID_V2Result)
+	if stored, ok := client.storedEntityByRecordID(dataSourceCode, recordID); ok && len(result) == 0 {
+		result = stored
+	}
+	keyed, ok := client.keyedResults.get("GetEntityByRecordID", dataSourceCode, recordID)
+	if ok {
+		result = keyed
+	}
+	if err == nil && !ok {
+		err = client.checkStored("GetEntityByRecordID_V2", dataSourceCode, recordID)
+	}
+	if err == nil && client.GetEntityByRecordID_V2Func != nil {
+		result, err = client.GetEntityByRecordID_V2Func(ctx, dataSourceCode, recordID, flags)
+	}
+	result = client.mockMetadata("GetEntityByRecordID_V2", result)
+	result = client.compress(result)
+	if err != nil {
+		result = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8038, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(78, dataSourceCode, recordID, flags, client.GetEntityByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(78, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 	}
-	return client.GetEntityByRecordID_V2Result, err
+	return result, err
 }
 
 /*
 The GetRecord method returns a JSON document of a single record from the Senzing repository.
 To control output, use GetRecord_V2() instead.
+In the mock, if GetRecordResult is empty, records of the Stateful repository are returned as they were added.
+Results set with SetRecordResult() take precedence.
+If Stateful is set, a record that is not in the repository and has no such result is an error.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1496,29 +3800,66 @@ Output
     See the example output.
 */
 func (client *G2engine) GetRecord(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(83, dataSourceCode, recordID)
 	}
+	client.Recorder.Record("g2engine", "GetRecord", dataSourceCode, recordID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetRecord"); err == nil {
+		defer client.finishCall("GetRecord", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("GetRecord", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("GetRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4039, dataSourceCode, recordID, -1)
+	}
+	if err == nil && !client.isReplicated(dataSourceCode, recordID) {
+		err = client.newError("GetRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4902, dataSourceCode, recordID)
+	}
+	result := client.stringResult(&client.GetRecordResult)
+	record, stored := client.records().get(dataSourceCode, recordID)
+	if stored && len(result) == 0 {
+		result = record.document(false)
+	}
+	keyed, ok := client.keyedResults.get("GetRecord", dataSourceCode, recordID)
+	if ok {
+		result = keyed
+	}
+	if err == nil && !ok {
+		err = client.checkStored("GetRecord", dataSourceCode, recordID)
+	}
+	if err == nil && client.GetRecordFunc != nil {
+		result, err = client.GetRecordFunc(ctx, dataSourceCode, recordID)
+	}
+	result = client.mockMetadata("GetRecord", result)
+	if err != nil {
+		result = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8039, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(84, dataSourceCode, recordID, client.GetRecordResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(84, dataSourceCode, recordID, result, err, time.Since(entryTime))
 	}
-	return client.GetRecordResult, err
+	return result, err
 }
 
 /*
 The GetRecord_V2 method returns a JSON document of a single record from the Senzing repository.
 It extends GetRecord() by adding output control flags.
+In the mock, if GetRecord_V2Result is empty, records of the Stateful repository are returned as they were added.
+If RecordProvenance is set, they are returned with a "_PROVENANCE" object.
+Results set with SetRecordResult() take precedence.
+If Stateful is set, a record that is not in the repository and has no such result is an error.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1531,30 +3872,70 @@ Output
     See the example output.
 */
 func (client *G2engine) GetRecord_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(85, dataSourceCode, recordID, flags)
 	}
+	client.Recorder.Record("g2engine", "GetRecord_V2", dataSourceCode, recordID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetRecord_V2"); err == nil {
+		defer client.finishCall("GetRecord_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("GetRecord_V2", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("GetRecord_V2", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4040, dataSourceCode, recordID, flags, -1)
+	}
+	if err == nil && !client.isReplicated(dataSourceCode, recordID) {
+		err = client.newError("GetRecord_V2", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4902, dataSourceCode, recordID)
+	}
+	client.recordFlags("GetRecord_V2", flags)
+	if err == nil {
+		err = client.checkFlags("GetRecord_V2", flags)
+	}
+	result := client.stringResult(&client.GetRecord_V2Result)
+	if record, stored := client.records().get(dataSourceCode, recordID); stored && (client.RecordProvenance || len(result) == 0) {
+		result = record.document(client.RecordProvenance)
+	}
+	keyed, ok := client.keyedResults.get("GetRecord", dataSourceCode, recordID)
+	if ok {
+		result = keyed
+	}
+	if err == nil && !ok {
+		err = client.checkStored("GetRecord_V2", dataSourceCode, recordID)
+	}
+	if err == nil && client.GetRecord_V2Func != nil {
+		result, err = client.GetRecord_V2Func(ctx, dataSourceCode, recordID, flags)
+	}
+	result = client.mockMetadata("GetRecord_V2", result)
+	if err != nil {
+		result = ""
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8040, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(86, dataSourceCode, recordID, flags, client.GetRecord_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(86, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 	}
-	return client.GetRecord_V2Result, err
+	return result, err
 }
 
 /*
 The GetRedoRecord method returns the next internally queued maintenance record from the Senzing repository.
 Usually, the ProcessRedoRecord() or ProcessRedoRecordWithInfo() method is called to process the maintenance record
 retrieved by GetRedoRecord().
+In the mock, results queued with EnqueueGetRedoRecordResult() are returned first.
+Then, once PushRedoRecord() has been called, records are removed from the redo queue,
+and an empty string is returned when it is empty, as by Senzing when there are no redo records.
+Otherwise GetRedoRecordResult is returned.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1563,26 +3944,44 @@ Output
   - A JSON document.
 */
 func (client *G2engine) GetRedoRecord(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(87)
 	}
+	client.Recorder.Record("g2engine", "GetRedoRecord")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetRedoRecord"); err == nil {
+		defer client.finishCall("GetRedoRecord", entryTime)
+	}
+	result := client.stringResult(&client.GetRedoRecordResult)
+	if err == nil {
+		if queued, ok := client.resultQueues.pop("GetRedoRecord"); ok {
+			result = queued
+		} else if redoRecord, ok := client.redo.pop(); ok {
+			result = redoRecord
+		}
+	}
+	if err == nil && client.GetRedoRecordFunc != nil {
+		result, err = client.GetRedoRecordFunc(ctx)
+	}
+	result = client.mockMetadata("GetRedoRecord", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8041, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(88, client.GetRedoRecordResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(88, result, err, time.Since(entryTime))
 	}
-	return client.GetRedoRecordResult, err
+	return result, err
 }
 
 /*
 The GetRepositoryLastModifiedTime method retrieves the last modified time of the Senzing repository,
 measured in the number of seconds between the last modified time and January 1, 1970 12:00am GMT (epoch time).
+In the mock, it is the latest of GetRepositoryLastModifiedTimeResult and the times set with SetDataSourceLastModifiedTime().
 
 Input
   - ctx: A context to control lifecycle.
@@ -1591,21 +3990,27 @@ Output
   - A Unix Timestamp.
 */
 func (client *G2engine) GetRepositoryLastModifiedTime(ctx context.Context) (int64, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(89)
 	}
+	client.Recorder.Record("g2engine", "GetRepositoryLastModifiedTime")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetRepositoryLastModifiedTime"); err == nil {
+		defer client.finishCall("GetRepositoryLastModifiedTime", entryTime)
+	}
+	result := client.repositoryLastModifiedTime()
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "result", strconv.FormatInt(result, 10))
 			client.notify(ctx, 8042, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(90, client.GetRepositoryLastModifiedTimeResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(90, result, err, time.Since(entryTime))
 	}
-	return client.GetRepositoryLastModifiedTimeResult, err
+	return result, err
 }
 
 /*
@@ -1617,18 +4022,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) GetSdkId(ctx context.Context) string {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(161)
 	}
+	client.Recorder.Record("g2engine", "GetSdkId")
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
-		go func() {
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8075, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(162, err, time.Since(entryTime))
 	}
 	return "mock"
@@ -1647,23 +4053,34 @@ Output
     Example: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"FEAT_DESC_VALUES":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"FEAT_DESC_VALUES":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"FEAT_DESC_VALUES":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:20:17.088","LAST_SEEN_DT":"2022-12-06 15:20:17.161"}],"LAST_SEEN_DT":"2022-12-06 15:20:17.161","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","LAST_SEEN_DT":"2022-12-06 15:20:17.088","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"222","ENTITY_TYPE":"TEST","INTERNAL_ID":2,"ENTITY_KEY":"740BA22D15CA88462A930AF8A7C904FF5E48226C","ENTITY_DESC":"OCEANGUY","LAST_SEEN_DT":"2022-12-06 15:20:17.161","FEATURES":[{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":24},{"LIB_FEAT_ID":25},{"LIB_FEAT_ID":26},{"LIB_FEAT_ID":27},{"LIB_FEAT_ID":28},{"LIB_FEAT_ID":29},{"LIB_FEAT_ID":30},{"LIB_FEAT_ID":31},{"LIB_FEAT_ID":32},{"LIB_FEAT_ID":33},{"LIB_FEAT_ID":34},{"LIB_FEAT_ID":35},{"LIB_FEAT_ID":36},{"LIB_FEAT_ID":37},{"LIB_FEAT_ID":38},{"LIB_FEAT_ID":39},{"LIB_FEAT_ID":40}]}]}}`
 */
 func (client *G2engine) GetVirtualEntityByRecordID(ctx context.Context, recordList string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(91, recordList)
 	}
+	client.Recorder.Record("g2engine", "GetVirtualEntityByRecordID", recordList)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetVirtualEntityByRecordID"); err == nil {
+		defer client.finishCall("GetVirtualEntityByRecordID", entryTime)
+	}
+	result := client.stringResult(&client.GetVirtualEntityByRecordIDResult)
+	if err == nil && client.GetVirtualEntityByRecordIDFunc != nil {
+		result, err = client.GetVirtualEntityByRecordIDFunc(ctx, recordList)
+	}
+	result = client.mockMetadata("GetVirtualEntityByRecordID", result)
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"recordList": recordList,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8043, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(92, recordList, client.GetVirtualEntityByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(92, recordList, result, err, time.Since(entryTime))
 	}
-	return client.GetVirtualEntityByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -1681,23 +4098,38 @@ Output
     See the example output.
 */
 func (client *G2engine) GetVirtualEntityByRecordID_V2(ctx context.Context, recordList string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(93, recordList, flags)
 	}
+	client.Recorder.Record("g2engine", "GetVirtualEntityByRecordID_V2", recordList, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "GetVirtualEntityByRecordID_V2"); err == nil {
+		defer client.finishCall("GetVirtualEntityByRecordID_V2", entryTime)
+	}
+	client.recordFlags("GetVirtualEntityByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("GetVirtualEntityByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.GetVirtualEntityByRecordID_V2Result)
+	if err == nil && client.GetVirtualEntityByRecordID_V2Func != nil {
+		result, err = client.GetVirtualEntityByRecordID_V2Func(ctx, recordList, flags)
+	}
+	result = client.mockMetadata("GetVirtualEntityByRecordID_V2", result)
+	result = client.compress(result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"recordList": recordList,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8044, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(94, recordList, flags, client.GetVirtualEntityByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(94, recordList, flags, result, err, time.Since(entryTime))
 	}
-	return client.GetVirtualEntityByRecordID_V2Result, err
+	return result, err
 }
 
 /*
@@ -1713,23 +4145,36 @@ Output
     See the example output.
 */
 func (client *G2engine) HowEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(95, entityID)
 	}
+	client.Recorder.Record("g2engine", "HowEntityByEntityID", entityID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "HowEntityByEntityID"); err == nil {
+		defer client.finishCall("HowEntityByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("HowEntityByEntityID", entityID)
+	}
+	result := client.stringResult(&client.HowEntityByEntityIDResult)
+	if err == nil && client.HowEntityByEntityIDFunc != nil {
+		result, err = client.HowEntityByEntityIDFunc(ctx, entityID)
+	}
+	result = client.mockMetadata("HowEntityByEntityID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8045, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(96, entityID, client.HowEntityByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(96, entityID, result, err, time.Since(entryTime))
 	}
-	return client.HowEntityByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -1746,28 +4191,49 @@ Output
     See the example output.
 */
 func (client *G2engine) HowEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(97, entityID, flags)
 	}
+	client.Recorder.Record("g2engine", "HowEntityByEntityID_V2", entityID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "HowEntityByEntityID_V2"); err == nil {
+		defer client.finishCall("HowEntityByEntityID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("HowEntityByEntityID_V2", entityID)
+	}
+	client.recordFlags("HowEntityByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("HowEntityByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.HowEntityByEntityID_V2Result)
+	if err == nil && client.HowEntityByEntityID_V2Func != nil {
+		result, err = client.HowEntityByEntityID_V2Func(ctx, entityID, flags)
+	}
+	result = client.mockMetadata("HowEntityByEntityID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8046, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(98, entityID, flags, client.HowEntityByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(98, entityID, flags, result, err, time.Since(entryTime))
 	}
-	return client.HowEntityByEntityID_V2Result, err
+	return result, err
 }
 
 /*
 The Init method initializes the Senzing G2 object.
 It must be called prior to any other calls.
+In the mock, it starts the cold start curve configured by ColdStartCalls and ColdStartFactor.
+It also applies the EnvLogLevel, EnvCallLatency, EnvChaosProfile and EnvFixtureDir environment variables.
+If ConfigCompatibilityVersion is set, the configuration must have that compatibility version.
+If StrictIniParams is set, iniParams must be a valid engine configuration.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1776,22 +4242,34 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2engine) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(99, moduleName, iniParams, verboseLogging)
 	}
+	client.Recorder.Record("g2engine", "Init", moduleName, iniParams, verboseLogging)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	err = client.configureFromEnvironment(ctx, "Init")
+	if err == nil {
+		err = client.checkIniParams("Init", iniParams)
+	}
+	if err == nil {
+		err = client.checkConfigCompatibility("Init", client.stringResult(&client.ExportConfigResult))
+	}
+	client.setColdStart(client.ColdStartCalls)
+	if err == nil {
+		client.lifecycle.transition(StateInitialized)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
 				"moduleName":     moduleName,
 				"verboseLogging": strconv.Itoa(verboseLogging),
 			}
 			client.notify(ctx, 8047, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(100, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -1800,6 +4278,10 @@ func (client *G2engine) Init(ctx context.Context, moduleName string, iniParams s
 /*
 The InitWithConfigID method initializes the Senzing G2 object with a non-default configuration ID.
 It must be called prior to any other calls.
+In the mock, it starts the cold start curve configured by ColdStartCalls and ColdStartFactor.
+It also applies the EnvLogLevel, EnvCallLatency, EnvChaosProfile and EnvFixtureDir environment variables.
+If ConfigCompatibilityVersion is set, the configuration must have that compatibility version.
+If StrictIniParams is set, iniParams must be a valid engine configuration.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1809,13 +4291,25 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2engine) InitWithConfigID(ctx context.Context, moduleName string, iniParams string, initConfigID int64, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(101, moduleName, iniParams, initConfigID, verboseLogging)
 	}
+	client.Recorder.Record("g2engine", "InitWithConfigID", moduleName, iniParams, initConfigID, verboseLogging)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	err = client.configureFromEnvironment(ctx, "InitWithConfigID")
+	if err == nil {
+		err = client.checkIniParams("InitWithConfigID", iniParams)
+	}
+	if err == nil {
+		err = client.checkConfigCompatibility("InitWithConfigID", client.selectedConfig(initConfigID))
+	}
+	client.setColdStart(client.ColdStartCalls)
+	if err == nil {
+		client.lifecycle.transition(StateInitialized)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
 				"initConfigID":   strconv.FormatInt(initConfigID, 10),
@@ -1823,9 +4317,9 @@ func (client *G2engine) InitWithConfigID(ctx context.Context, moduleName string,
 				"verboseLogging": strconv.Itoa(verboseLogging),
 			}
 			client.notify(ctx, 8048, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(102, moduleName, iniParams, initConfigID, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -1835,23 +4329,29 @@ func (client *G2engine) InitWithConfigID(ctx context.Context, moduleName string,
 The PrimeEngine method pre-initializes some of the heavier weight internal resources of the G2 engine.
 The G2 Engine uses "lazy initialization".
 PrimeEngine() forces initialization.
+In the mock, PrimeEngine() also ends the cold start curve configured by ColdStartCalls and ColdStartFactor.
 
 Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) PrimeEngine(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(103)
 	}
+	client.Recorder.Record("g2engine", "PrimeEngine")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.setColdStart(0)
+	if err == nil {
+		client.lifecycle.transition(StatePrimed)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8049, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(104, err, time.Since(entryTime))
 	}
 	return err
@@ -1865,18 +4365,25 @@ Input
   - record: A JSON document containing the record to be added to the Senzing repository.
 */
 func (client *G2engine) Process(ctx context.Context, record string) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(105, record)
 	}
+	client.Recorder.Record("g2engine", "Process", record)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "Process"); err == nil {
+		defer client.finishCall("Process", entryTime)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", record)
 			client.notify(ctx, 8050, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(106, record, err, time.Since(entryTime))
 	}
 	return err
@@ -1885,6 +4392,8 @@ func (client *G2engine) Process(ctx context.Context, record string) error {
 /*
 The ProcessRedoRecord method processes the next redo record and returns it.
 Calling ProcessRedoRecord() has the potential to create more redo records in certain situations.
+In the mock, once PushRedoRecord() has been called, it removes and returns the next record of the redo queue,
+or an empty string when it is empty. Otherwise it returns ProcessRedoRecordResult.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1893,26 +4402,45 @@ Output
   - A JSON document.
 */
 func (client *G2engine) ProcessRedoRecord(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(107)
 	}
+	client.Recorder.Record("g2engine", "ProcessRedoRecord")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ProcessRedoRecord"); err == nil {
+		defer client.finishCall("ProcessRedoRecord", entryTime)
+	}
+	result := client.stringResult(&client.ProcessRedoRecordResult)
+	if err == nil {
+		if redoRecord, ok := client.redo.pop(); ok {
+			result = redoRecord
+		}
+	}
+	if err == nil && client.ProcessRedoRecordFunc != nil {
+		result, err = client.ProcessRedoRecordFunc(ctx)
+	}
+	result = client.mockMetadata("ProcessRedoRecord", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8051, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(108, client.ProcessRedoRecordResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(108, result, err, time.Since(entryTime))
 	}
-	return client.ProcessRedoRecordResult, err
+	return result, err
 }
 
 /*
 The ProcessRedoRecordWithInfo method processes the next redo record and returns it and affected entities.
 Calling ProcessRedoRecordWithInfo() has the potential to create more redo records in certain situations.
+In the mock, once PushRedoRecord() has been called, it removes and returns the next record of the redo queue
+with ProcessRedoRecordWithInfoResultWithInfo, or two empty strings when it is empty.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1923,21 +4451,40 @@ Output
   - A JSON document with affected entities.
 */
 func (client *G2engine) ProcessRedoRecordWithInfo(ctx context.Context, flags int64) (string, string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(109, flags)
 	}
+	client.Recorder.Record("g2engine", "ProcessRedoRecordWithInfo", flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ProcessRedoRecordWithInfo"); err == nil {
+		defer client.finishCall("ProcessRedoRecordWithInfo", entryTime)
+	}
+	result := client.stringResult(&client.ProcessRedoRecordWithInfoResult)
+	withInfo := client.stringResult(&client.ProcessRedoRecordWithInfoResultWithInfo)
+	if err == nil {
+		if redoRecord, ok := client.redo.pop(); ok {
+			result = redoRecord
+			if len(redoRecord) == 0 {
+				withInfo = ""
+			}
+		}
+	}
+	if err == nil && client.ProcessRedoRecordWithInfoFunc != nil {
+		result, withInfo, err = client.ProcessRedoRecordWithInfoFunc(ctx, flags)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8052, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(110, flags, client.ProcessRedoRecordWithInfoResult, client.ProcessRedoRecordWithInfoResultWithInfo, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(110, flags, result, withInfo, err, time.Since(entryTime))
 	}
-	return client.ProcessRedoRecordWithInfoResult, client.ProcessRedoRecordWithInfoResultWithInfo, err
+	return result, withInfo, err
 }
 
 /*
@@ -1953,21 +4500,34 @@ Output
     See the example output.
 */
 func (client *G2engine) ProcessWithInfo(ctx context.Context, record string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(111, record, flags)
 	}
+	client.Recorder.Record("g2engine", "ProcessWithInfo", record, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ProcessWithInfo"); err == nil {
+		defer client.finishCall("ProcessWithInfo", entryTime)
+	}
+	result := client.stringResult(&client.ProcessWithInfoResult)
+	if err == nil && client.ProcessWithInfoFunc != nil {
+		result, err = client.ProcessWithInfoFunc(ctx, record, flags)
+	}
+	result = client.mockMetadata("ProcessWithInfo", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", record)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8053, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(112, record, flags, client.ProcessWithInfoResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(112, record, flags, result, err, time.Since(entryTime))
 	}
-	return client.ProcessWithInfoResult, err
+	return result, err
 }
 
 /*
@@ -1982,21 +4542,34 @@ Output
     See the example output.
 */
 func (client *G2engine) ProcessWithResponse(ctx context.Context, record string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(113, record)
 	}
+	client.Recorder.Record("g2engine", "ProcessWithResponse", record)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ProcessWithResponse"); err == nil {
+		defer client.finishCall("ProcessWithResponse", entryTime)
+	}
+	result := client.stringResult(&client.ProcessWithResponseResult)
+	if err == nil && client.ProcessWithResponseFunc != nil {
+		result, err = client.ProcessWithResponseFunc(ctx, record)
+	}
+	result = client.mockMetadata("ProcessWithResponse", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", record)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8054, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(114, record, client.ProcessWithResponseResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(114, record, result, err, time.Since(entryTime))
 	}
-	return client.ProcessWithResponseResult, err
+	return result, err
 }
 
 /*
@@ -2011,21 +4584,34 @@ Output
     See the example output.
 */
 func (client *G2engine) ProcessWithResponseResize(ctx context.Context, record string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(115, record)
 	}
+	client.Recorder.Record("g2engine", "ProcessWithResponseResize", record)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ProcessWithResponseResize"); err == nil {
+		defer client.finishCall("ProcessWithResponseResize", entryTime)
+	}
+	result := client.stringResult(&client.ProcessWithResponseResizeResult)
+	if err == nil && client.ProcessWithResponseResizeFunc != nil {
+		result, err = client.ProcessWithResponseResizeFunc(ctx, record)
+	}
+	result = client.mockMetadata("ProcessWithResponseResize", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", record)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8055, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(116, record, client.ProcessWithResponseResizeResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(116, record, result, err, time.Since(entryTime))
 	}
-	return client.ProcessWithResponseResizeResult, err
+	return result, err
 }
 
 /*
@@ -2039,18 +4625,28 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) PurgeRepository(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(117)
 	}
+	client.Recorder.Record("g2engine", "PurgeRepository")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "PurgeRepository"); err == nil {
+		defer client.finishCall("PurgeRepository", entryTime)
+	}
+	if err == nil {
+		client.simulatePurge(ctx)
+	}
+	if err == nil {
+		client.lifecycle.transition(StatePurged)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
 			client.notify(ctx, 8056, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(118, err, time.Since(entryTime))
 	}
 	return err
@@ -2065,20 +4661,26 @@ Input
   - flags: Flags used to control information returned.
 */
 func (client *G2engine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(119, entityID, flags)
 	}
+	client.Recorder.Record("g2engine", "ReevaluateEntity", entityID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ReevaluateEntity"); err == nil {
+		defer client.finishCall("ReevaluateEntity", entryTime)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
 			client.notify(ctx, 8057, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(120, entityID, flags, err, time.Since(entryTime))
 	}
 	return err
@@ -2098,23 +4700,35 @@ Output
     See the example output.
 */
 func (client *G2engine) ReevaluateEntityWithInfo(ctx context.Context, entityID int64, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(121, entityID, flags)
 	}
+	client.Recorder.Record("g2engine", "ReevaluateEntityWithInfo", entityID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ReevaluateEntityWithInfo"); err == nil {
+		defer client.finishCall("ReevaluateEntityWithInfo", entryTime)
+	}
+	result := client.stringResult(&client.ReevaluateEntityWithInfoResult)
+	if err == nil && client.ReevaluateEntityWithInfoFunc != nil {
+		result, err = client.ReevaluateEntityWithInfoFunc(ctx, entityID, flags)
+	}
+	result = client.mockMetadata("ReevaluateEntityWithInfo", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8058, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(122, entityID, flags, client.ReevaluateEntityWithInfoResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(122, entityID, flags, result, err, time.Since(entryTime))
 	}
-	return client.ReevaluateEntityWithInfoResult, err
+	return result, err
 }
 
 /*
@@ -2127,21 +4741,33 @@ Input
   - flags: Flags used to control information returned.
 */
 func (client *G2engine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(123, dataSourceCode, recordID, flags)
 	}
+	client.Recorder.Record("g2engine", "ReevaluateRecord", dataSourceCode, recordID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ReevaluateRecord"); err == nil {
+		defer client.finishCall("ReevaluateRecord", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("ReevaluateRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4059, dataSourceCode, recordID, flags, -1)
+	}
+	if err == nil && client.callPolicies.fail("ReevaluateRecord", dataSourceCode, recordID) {
+		err = client.newError("ReevaluateRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "ReevaluateRecord", dataSourceCode, recordID)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
 			client.notify(ctx, 8059, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(124, dataSourceCode, recordID, flags, err, time.Since(entryTime))
 	}
 	return err
@@ -2149,6 +4775,7 @@ func (client *G2engine) ReevaluateRecord(ctx context.Context, dataSourceCode str
 
 /*
 The ReevaluateRecordWithInfo method FIXME:
+In the mock, if Stateful is set and ReevaluateRecordWithInfoResult is empty, the result lists the entity of the record.
 
 Input
   - ctx: A context to control lifecycle.
@@ -2162,24 +4789,47 @@ Output
     See the example output.
 */
 func (client *G2engine) ReevaluateRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(125, dataSourceCode, recordID, flags)
 	}
+	client.Recorder.Record("g2engine", "ReevaluateRecordWithInfo", dataSourceCode, recordID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ReevaluateRecordWithInfo"); err == nil {
+		defer client.finishCall("ReevaluateRecordWithInfo", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("ReevaluateRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4060, dataSourceCode, recordID, flags, -1)
+	}
+	if err == nil && client.callPolicies.fail("ReevaluateRecordWithInfo", dataSourceCode, recordID) {
+		err = client.newError("ReevaluateRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "ReevaluateRecordWithInfo", dataSourceCode, recordID)
+	}
+	result := ""
+	if err == nil {
+		result = client.withInfoResult(dataSourceCode, recordID, client.stringResult(&client.ReevaluateRecordWithInfoResult))
+	}
+	if err == nil && client.ReevaluateRecordWithInfoFunc != nil {
+		result, err = client.ReevaluateRecordWithInfoFunc(ctx, dataSourceCode, recordID, flags)
+	}
+	if err == nil {
+		result = client.mockMetadata("ReevaluateRecordWithInfo", result)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8060, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(126, dataSourceCode, recordID, flags, client.ReevaluateRecordWithInfoResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(126, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 	}
-	return client.ReevaluateRecordWithInfoResult, err
+	return result, err
 }
 
 /*
@@ -2190,23 +4840,21 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2engine) RegisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(157, observer.GetObserverId(ctx))
 	}
+	client.Recorder.Record("g2engine", "RegisterObserver", observer.GetObserverId(ctx))
 	entryTime := time.Now()
-	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
-	}
-	err := client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
-		go func() {
+	err := client.observers.Register(ctx, observer)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"observerID": observer.GetObserverId(ctx),
 			}
 			client.notify(ctx, 8076, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(158, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -2214,26 +4862,34 @@ func (client *G2engine) RegisterObserver(ctx context.Context, observer observer.
 
 /*
 The Reinit method re-initializes the Senzing G2Engine object using a specified configuration identifier.
+In the mock, if ConfigCompatibilityVersion is set, the configuration must have that compatibility version.
 
 Input
   - ctx: A context to control lifecycle.
   - initConfigID: The configuration ID used for the initialization.
 */
 func (client *G2engine) Reinit(ctx context.Context, initConfigID int64) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(127, initConfigID)
 	}
+	client.Recorder.Record("g2engine", "Reinit", initConfigID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "Reinit"); err == nil {
+		defer client.finishCall("Reinit", entryTime)
+	}
+	if err == nil {
+		err = client.checkConfigCompatibility("Reinit", client.selectedConfig(initConfigID))
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"initConfigID": strconv.FormatInt(initConfigID, 10),
 			}
 			client.notify(ctx, 8061, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(128, initConfigID, err, time.Since(entryTime))
 	}
 	return err
@@ -2251,22 +4907,41 @@ Input
   - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
 */
 func (client *G2engine) ReplaceRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(129, dataSourceCode, recordID, jsonData, loadID)
 	}
+	client.Recorder.Record("g2engine", "ReplaceRecord", dataSourceCode, recordID, jsonData, loadID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ReplaceRecord"); err == nil {
+		defer client.finishCall("ReplaceRecord", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("ReplaceRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4062, dataSourceCode, recordID, jsonData, loadID, -1)
+	}
+	if err == nil && client.callPolicies.fail("ReplaceRecord", dataSourceCode, recordID) {
+		err = client.newError("ReplaceRecord", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "ReplaceRecord", dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.trackReplication(dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.storeRecord(dataSourceCode, recordID, jsonData, loadID)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "jsonData", jsonData)
 			client.notify(ctx, 8062, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(130, dataSourceCode, recordID, jsonData, loadID, err, time.Since(entryTime))
 	}
 	return err
@@ -2275,6 +4950,7 @@ func (client *G2engine) ReplaceRecord(ctx context.Context, dataSourceCode string
 /*
 The ReplaceRecordWithInfo method updates/replaces a record in the Senzing repository and returns information on the affected entities.
 If record doesn't exist, a new record is added to the data repository.
+In the mock, if Stateful is set and ReplaceRecordWithInfoResult is empty, the result lists the entity of the record.
 
 Input
   - ctx: A context to control lifecycle.
@@ -2289,25 +4965,63 @@ Output
     See the example output.
 */
 func (client *G2engine) ReplaceRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(131, dataSourceCode, recordID, jsonData, loadID, flags)
 	}
+	client.Recorder.Record("g2engine", "ReplaceRecordWithInfo", dataSourceCode, recordID, jsonData, loadID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	client.purgeMutex.RLock()
+	defer client.purgeMutex.RUnlock()
+	if err = client.startCall(ctx, "ReplaceRecordWithInfo"); err == nil {
+		defer client.finishCall("ReplaceRecordWithInfo", entryTime)
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("ReplaceRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4063, dataSourceCode, recordID, jsonData, loadID, flags, -1)
+	}
+	if err == nil && client.callPolicies.fail("ReplaceRecordWithInfo", dataSourceCode, recordID) {
+		err = client.newError("ReplaceRecordWithInfo", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4903, "ReplaceRecordWithInfo", dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.trackReplication(dataSourceCode, recordID)
+	}
+	featureChanges := err == nil && client.Stateful && client.FeatureChanges
+	var previous storedRecord
+	if featureChanges {
+		previous, _ = client.records().get(dataSourceCode, recordID)
+	}
+	if err == nil {
+		client.storeRecord(dataSourceCode, recordID, jsonData, loadID)
+	}
+	result := ""
+	if err == nil {
+		result = client.withInfoResult(dataSourceCode, recordID, client.stringResult(&client.ReplaceRecordWithInfoResult))
+	}
+	if featureChanges {
+		result = client.withFeatureChanges(result, previous, dataSourceCode, recordID)
+	}
+	if err == nil && client.ReplaceRecordWithInfoFunc != nil {
+		result, err = client.ReplaceRecordWithInfoFunc(ctx, dataSourceCode, recordID, jsonData, loadID, flags)
+	}
+	if err == nil {
+		result = client.mockMetadata("ReplaceRecordWithInfo", result)
+	}
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 				"loadID":         loadID,
 			}
+			client.addPayload(details, "jsonData", jsonData)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8063, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(132, dataSourceCode, recordID, jsonData, loadID, flags, client.ReplaceRecordWithInfoResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(132, dataSourceCode, recordID, jsonData, loadID, flags, result, err, time.Since(entryTime))
 	}
-	return client.ReplaceRecordWithInfoResult, err
+	return result, err
 }
 
 /*
@@ -2323,21 +5037,35 @@ Output
     Example: `{"RESOLVED_ENTITIES":[{"MATCH_INFO":{"MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","MATCH_KEY":"+NAME+SSN","ERRULE_CODE":"SF1_PNAME_CSTAB","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT":"JOHNSON","CANDIDATE_FEAT":"JOHNSON","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1}],"SSN":[{"INBOUND_FEAT":"053-39-3251","CANDIDATE_FEAT":"053-39-3251","FULL_SCORE":100}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 15:38:06.175","LAST_SEEN_DT":"2022-12-06 15:38:06.957"}],"LAST_SEEN_DT":"2022-12-06 15:38:06.957"}}}]}`
 */
 func (client *G2engine) SearchByAttributes(ctx context.Context, jsonData string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(133, jsonData)
 	}
+	client.Recorder.Record("g2engine", "SearchByAttributes", jsonData)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "SearchByAttributes"); err == nil {
+		defer client.finishCall("SearchByAttributes", entryTime)
+	}
+	result := client.stringResult(&client.SearchByAttributesResult)
+	if err == nil && client.replicationPending() {
+		result = `{"RESOLVED_ENTITIES":[]}`
+	}
+	if err == nil && client.SearchByAttributesFunc != nil {
+		result, err = client.SearchByAttributesFunc(ctx, jsonData)
+	}
+	result = client.mockMetadata("SearchByAttributes", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", jsonData)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8064, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(134, jsonData, client.SearchByAttributesResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(134, jsonData, result, err, time.Since(entryTime))
 	}
-	return client.SearchByAttributesResult, err
+	return result, err
 }
 
 /*
@@ -2354,21 +5082,39 @@ Output
     See the example output.
 */
 func (client *G2engine) SearchByAttributes_V2(ctx context.Context, jsonData string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(135, jsonData, flags)
 	}
+	client.Recorder.Record("g2engine", "SearchByAttributes_V2", jsonData, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "SearchByAttributes_V2"); err == nil {
+		defer client.finishCall("SearchByAttributes_V2", entryTime)
+	}
+	client.recordFlags("SearchByAttributes_V2", flags)
+	if err == nil {
+		err = client.checkFlags("SearchByAttributes_V2", flags)
+	}
+	result := client.stringResult(&client.SearchByAttributes_V2Result)
+	if err == nil && client.replicationPending() {
+		result = `{"RESOLVED_ENTITIES":[]}`
+	}
+	if err == nil && client.SearchByAttributes_V2Func != nil {
+		result, err = client.SearchByAttributes_V2Func(ctx, jsonData, flags)
+	}
+	result = client.mockMetadata("SearchByAttributes_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "jsonData", jsonData)
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8065, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(136, jsonData, flags, client.SearchByAttributes_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(136, jsonData, flags, result, err, time.Since(entryTime))
 	}
-	return client.SearchByAttributes_V2Result, err
+	return result, err
 }
 
 /*
@@ -2379,22 +5125,26 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2engine) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(137, logLevel)
 	}
+	client.Recorder.Record("g2engine", "SetLogLevel", logLevel)
 	entryTime := time.Now()
 	var err error = nil
-	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
-	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
-	if client.observers != nil {
-		go func() {
+	messageLogger := client.getLogger()
+	client.logMutex.Lock()
+	messageLogger.SetLogLevel(messagelogger.Level(logLevel))
+	client.isTrace.Store(messageLogger.GetLogLevel() == messagelogger.LevelTrace)
+	client.logMutex.Unlock()
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"logLevel": logger.LevelToTextMap[logLevel],
 			}
 			client.notify(ctx, 8077, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(138, logLevel, err, time.Since(entryTime))
 	}
 	return err
@@ -2403,6 +5153,9 @@ func (client *G2engine) SetLogLevel(ctx context.Context, logLevel logger.Level)
 /*
 The Stats method retrieves workload statistics for the current process.
 These statistics will automatically reset after retrieval.
+If StatsResult is not set, the mock generates a document whose "threadState" counts the calls in flight,
+with MaxConcurrentCalls, or else the LogicalCores of ResourceProfile, as the worker threads,
+and whose "systemResources" reports the ResourceProfile.
 
 Input
   - ctx: A context to control lifecycle.
@@ -2412,21 +5165,34 @@ Output
     Example: `{"workload":{"loadedRecords":5,"addedRecords":2,"deletedRecords":0,"reevaluations":0,"repairedEntities":0,"duration":56,"retries":0,"candidates":19,"actualAmbiguousTest":0,"cachedAmbiguousTest":0,"libFeatCacheHit":219,"libFeatCacheMiss":73,"unresolveTest":1,"abortedUnresolve":0,"gnrScorersUsed":1,"unresolveTriggers":{"normalResolve":0,"update":0,"relLink":0,"extensiveResolve":0,"ambiguousNoResolve":1,"ambiguousMultiResolve":0},"reresolveTriggers":{"abortRetry":0,"unresolveMovement":0,"multipleResolvableCandidates":0,"resolveNewFeatures":1,"newFeatureFTypes":[{"DOB":1}]},"reresolveSkipped":0,"filteredObsFeat":0,"expressedFeatureCalls":[{"EFCALL_ID":1,"EFUNC_CODE":"PHONE_HASHER","numCalls":1},{"EFCALL_ID":2,"EFUNC_CODE":"EXPRESS_ID","numCalls":1},{"EFCALL_ID":3,"EFUNC_CODE":"EXPRESS_ID","numCalls":1},{"EFCALL_ID":5,"EFUNC_CODE":"EXPRESS_BOM","numCalls":1},{"EFCALL_ID":7,"EFUNC_CODE":"NAME_HASHER","numCalls":4},{"EFCALL_ID":9,"EFUNC_CODE":"ADDR_HASHER","numCalls":1},{"EFCALL_ID":10,"EFUNC_CODE":"EXPRESS_BOM","numCalls":1},{"EFCALL_ID":14,"EFUNC_CODE":"EXPRESS_ID","numCalls":1},{"EFCALL_ID":16,"EFUNC_CODE":"EXPRESS_ID","numCalls":4}],"expressedFeaturesCreated":[{"ADDR_KEY":2},{"ID_KEY":7},{"NAME_KEY":14},{"PHONE_KEY":1},{"SEARCH_KEY":2}],"scoredPairs":[{"ACCT_NUM":16},{"ADDRESS":16},{"DOB":25},{"GENDER":16},{"LOGIN_ID":16},{"NAME":19},{"PHONE":16},{"SSN":19}],"cacheHit":[{"ADDRESS":12},{"DOB":18},{"NAME":13},{"PHONE":15}],"cacheMiss":[{"ADDRESS":4},{"DOB":7},{"NAME":6},{"PHONE":1}],"redoTriggers":[],"latchContention":[],"highContentionFeat":[],"highContentionResEnt":[],"genericDetect":[],"candidateBuilders":[{"ACCT_NUM":7},{"ADDR_KEY":7},{"DOB":7},{"ID_KEY":9},{"LOGIN_ID":7},{"NAME_KEY":9},{"PHONE":7},{"PHONE_KEY":7},{"SEARCH_KEY":7},{"SSN":9}],"suppressedCandidateBuilders":[],"suppressedScoredFeatureType":[],"reducedScoredFeatureType":[],"suppressedDisclosedRelationshipDomainCount":0,"CorruptEntityTestDiagnosis":{},"threadState":{"active":0,"idle":4,"sqlExecuting":0,"loader":0,"resolver":0,"scoring":0,"dataLatchContention":0,"obsEntContention":0,"resEntContention":0},"systemResources":{"initResources":[{"physicalCores":16},{"logicalCores":16},{"totalMemory":"62.6GB"},{"availableMemory":"49.5GB"}],"currResources":[{"availableMemory":"47.4GB"},{"activeThreads":0},{"workerThreads":4},{"systemLoad":[{"cpuUser":13.442277},{"cpuSystem":2.635741},{"cpuIdle":82.024246},{"cpuWait":1.634159},{"cpuSoftIrq":0.263574}]}]}}}`
 */
 func (client *G2engine) Stats(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(139)
 	}
+	client.Recorder.Record("g2engine", "Stats")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "Stats"); err == nil {
+		defer client.finishCall("Stats", entryTime)
+	}
+	result := client.stringResult(&client.StatsResult)
+	if len(result) == 0 {
+		result = client.statsDocument()
+	}
+	if err == nil && client.StatsFunc != nil {
+		result, err = client.StatsFunc(ctx)
+	}
+	result = client.mockMetadata("Stats", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8066, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(140, client.StatsResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(140, result, err, time.Since(entryTime))
 	}
-	return client.StatsResult, err
+	return result, err
 }
 
 /*
@@ -2437,26 +5203,27 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2engine) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(159, observer.GetObserverId(ctx))
 	}
+	client.Recorder.Record("g2engine", "UnregisterObserver", observer.GetObserverId(ctx))
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
+	err = client.UnregisterObserverError
+	if client.getObservers() != nil {
 		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
+		// client.notify is called synchronously before the observer is unregistered.
 		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// Then the observer may be unregistered, but observer goroutines will be OK.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8078, err, details)
 	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+	if err == nil {
+		err = client.observers.Unregister(ctx, observer)
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(160, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -2479,24 +5246,37 @@ Output
     Example: `{"WHY_RESULTS":[{"ENTITY_ID":1,"ENTITY_ID_2":2,"MATCH_INFO":{"WHY_KEY":"+PHONE+ACCT_NUM-SSN","WHY_ERRULE_CODE":"SF1","MATCH_LEVEL_CODE":"POSSIBLY_RELATED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}]},"DISCLOSED_RELATIONS":{},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":26,"CANDIDATE_FEAT":"772 Armstrong RD Delhi WI 53543","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":81,"SCORE_BUCKET":"LIKELY","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":25,"CANDIDATE_FEAT":"6/9/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":79,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"FMES"},{"INBOUND_FEAT_ID":2,"INBOUND_FEAT":"4/8/1983","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":25,"CANDIDATE_FEAT":"6/9/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":86,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":28,"CANDIDATE_FEAT":"flavorh2","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":24,"CANDIDATE_FEAT":"OCEANGUY","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":33,"GNR_SN":32,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":27,"CANDIDATE_FEAT":"153-33-5185","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 15:58:57.129","LAST_SEEN_DT":"2022-12-06 15:58:57.906"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.906","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:58:57.906","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.400","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.404","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.407","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.410","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.259","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.201","LAST_SEEN_DT":"2022-12-06 15:58:57.201"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.201"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.263","LAST_SEEN_DT":"2022-12-06 15:58:57.263"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.263"}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"FEAT_DESC_VALUES":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"FEAT_DESC_VALUES":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"FEAT_DESC_VALUES":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.201","LAST_SEEN_DT":"2022-12-06 15:58:57.201"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.201","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"222","ENTITY_TYPE":"TEST","INTERNAL_ID":2,"ENTITY_KEY":"740BA22D15CA88462A930AF8A7C904FF5E48226C","ENTITY_DESC":"OCEANGUY","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:58:57.201","FEATURES":[{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":24},{"LIB_FEAT_ID":25},{"LIB_FEAT_ID":26},{"LIB_FEAT_ID":27},{"LIB_FEAT_ID":28},{"LIB_FEAT_ID":29},{"LIB_FEAT_ID":30},{"LIB_FEAT_ID":31},{"LIB_FEAT_ID":32},{"LIB_FEAT_ID":33},{"LIB_FEAT_ID":34},{"LIB_FEAT_ID":35},{"LIB_FEAT_ID":36},{"LIB_FEAT_ID":37},{"LIB_FEAT_ID":38},{"LIB_FEAT_ID":39},{"LIB_FEAT_ID":40}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 15:58:57.129","LAST_SEEN_DT":"2022-12-06 15:58:57.906"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.906"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.263","LAST_SEEN_DT":"2022-12-06 15:58:57.263"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.263"}]}]}`
 */
 func (client *G2engine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(141, entityID1, entityID2)
 	}
+	client.Recorder.Record("g2engine", "WhyEntities", entityID1, entityID2)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyEntities"); err == nil {
+		defer client.finishCall("WhyEntities", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("WhyEntities", entityID1, entityID2)
+	}
+	result := client.stringResult(&client.WhyEntitiesResult)
+	if err == nil && client.WhyEntitiesFunc != nil {
+		result, err = client.WhyEntitiesFunc(ctx, entityID1, entityID2)
+	}
+	result = client.mockMetadata("WhyEntities", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8067, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(142, entityID1, entityID2, client.WhyEntitiesResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(142, entityID1, entityID2, result, err, time.Since(entryTime))
 	}
-	return client.WhyEntitiesResult, err
+	return result, err
 }
 
 /*
@@ -2517,24 +5297,41 @@ Output
     See the example output.
 */
 func (client *G2engine) WhyEntities_V2(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(143, entityID1, entityID2, flags)
 	}
+	client.Recorder.Record("g2engine", "WhyEntities_V2", entityID1, entityID2, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyEntities_V2"); err == nil {
+		defer client.finishCall("WhyEntities_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("WhyEntities_V2", entityID1, entityID2)
+	}
+	client.recordFlags("WhyEntities_V2", flags)
+	if err == nil {
+		err = client.checkFlags("WhyEntities_V2", flags)
+	}
+	result := client.stringResult(&client.WhyEntities_V2Result)
+	if err == nil && client.WhyEntities_V2Func != nil {
+		result, err = client.WhyEntities_V2Func(ctx, entityID1, entityID2, flags)
+	}
+	result = client.mockMetadata("WhyEntities_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID1": strconv.FormatInt(entityID1, 10),
 				"entityID2": strconv.FormatInt(entityID2, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8068, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(144, entityID1, entityID2, flags, client.WhyEntities_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(144, entityID1, entityID2, flags, result, err, time.Since(entryTime))
 	}
-	return client.WhyEntities_V2Result, err
+	return result, err
 }
 
 /*
@@ -2551,23 +5348,36 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":2,"INBOUND_FEAT":"4/8/1983","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":100001,"CANDIDATE_FEAT":"4/8/1985","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":2,"CANDIDATE_FEAT":"4/8/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:02:35.306","LAST_SEEN_DT":"2022-12-06 16:02:36.083"}],"LAST_SEEN_DT":"2022-12-06 16:02:36.083","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:02:36.083","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.572","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.575","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.579","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.582","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.432","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:02:35.373","LAST_SEEN_DT":"2022-12-06 16:02:35.373"}],"LAST_SEEN_DT":"2022-12-06 16:02:35.373"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:02:35.436","LAST_SEEN_DT":"2022-12-06 16:02:35.436"}],"LAST_SEEN_DT":"2022-12-06 16:02:35.436"}]}]}`
 */
 func (client *G2engine) WhyEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(145, entityID)
 	}
+	client.Recorder.Record("g2engine", "WhyEntityByEntityID", entityID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyEntityByEntityID"); err == nil {
+		defer client.finishCall("WhyEntityByEntityID", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("WhyEntityByEntityID", entityID)
+	}
+	result := client.stringResult(&client.WhyEntityByEntityIDResult)
+	if err == nil && client.WhyEntityByEntityIDFunc != nil {
+		result, err = client.WhyEntityByEntityIDFunc(ctx, entityID)
+	}
+	result = client.mockMetadata("WhyEntityByEntityID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8069, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(146, entityID, client.WhyEntityByEntityIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(146, entityID, result, err, time.Since(entryTime))
 	}
-	return client.WhyEntityByEntityIDResult, err
+	return result, err
 }
 
 /*
@@ -2584,23 +5394,40 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1}}]}`
 */
 func (client *G2engine) WhyEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(147, entityID, flags)
 	}
+	client.Recorder.Record("g2engine", "WhyEntityByEntityID_V2", entityID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyEntityByEntityID_V2"); err == nil {
+		defer client.finishCall("WhyEntityByEntityID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkEntityAccess("WhyEntityByEntityID_V2", entityID)
+	}
+	client.recordFlags("WhyEntityByEntityID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("WhyEntityByEntityID_V2", flags)
+	}
+	result := client.stringResult(&client.WhyEntityByEntityID_V2Result)
+	if err == nil && client.WhyEntityByEntityID_V2Func != nil {
+		result, err = client.WhyEntityByEntityID_V2Func(ctx, entityID, flags)
+	}
+	result = client.mockMetadata("WhyEntityByEntityID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"entityID": strconv.FormatInt(entityID, 10),
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8070, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(148, entityID, flags, client.WhyEntityByEntityID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(148, entityID, flags, result, err, time.Since(entryTime))
 	}
-	return client.WhyEntityByEntityID_V2Result, err
+	return result, err
 }
 
 /*
@@ -2617,24 +5444,40 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":2,"INBOUND_FEAT":"4/8/1983","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":100001,"CANDIDATE_FEAT":"4/8/1985","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":2,"CANDIDATE_FEAT":"4/8/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:09:05.626","LAST_SEEN_DT":"2022-12-06 16:09:06.399"}],"LAST_SEEN_DT":"2022-12-06 16:09:06.399","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:09:06.399","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.954","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.957","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.960","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.963","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.789","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:09:05.724","LAST_SEEN_DT":"2022-12-06 16:09:05.724"}],"LAST_SEEN_DT":"2022-12-06 16:09:05.724"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:09:05.794","LAST_SEEN_DT":"2022-12-06 16:09:05.794"}],"LAST_SEEN_DT":"2022-12-06 16:09:05.794"}]}]}`
 */
 func (client *G2engine) WhyEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(149, dataSourceCode, recordID)
 	}
+	client.Recorder.Record("g2engine", "WhyEntityByRecordID", dataSourceCode, recordID)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyEntityByRecordID"); err == nil {
+		defer client.finishCall("WhyEntityByRecordID", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("WhyEntityByRecordID", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("WhyEntityByRecordID", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4071, dataSourceCode, recordID, -1)
+	}
+	result := client.stringResult(&client.WhyEntityByRecordIDResult)
+	if err == nil && client.WhyEntityByRecordIDFunc != nil {
+		result, err = client.WhyEntityByRecordIDFunc(ctx, dataSourceCode, recordID)
+	}
+	result = client.mockMetadata("WhyEntityByRecordID", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8071, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(150, dataSourceCode, recordID, client.WhyEntityByRecordIDResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(150, dataSourceCode, recordID, result, err, time.Since(entryTime))
 	}
-	return client.WhyEntityByRecordIDResult, err
+	return result, err
 }
 
 /*
@@ -2652,24 +5495,44 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1}}]}`
 */
 func (client *G2engine) WhyEntityByRecordID_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(151, dataSourceCode, recordID, flags)
 	}
+	client.Recorder.Record("g2engine", "WhyEntityByRecordID_V2", dataSourceCode, recordID, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyEntityByRecordID_V2"); err == nil {
+		defer client.finishCall("WhyEntityByRecordID_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("WhyEntityByRecordID_V2", recordKey{dataSourceCode, recordID})
+	}
+	if err == nil && client.simulateDataSourceProfile(ctx, dataSourceCode) {
+		err = client.newError("WhyEntityByRecordID_V2", map[string]string{"dataSourceCode": dataSourceCode, "recordID": recordID}, 4072, dataSourceCode, recordID, flags, -1)
+	}
+	client.recordFlags("WhyEntityByRecordID_V2", flags)
+	if err == nil {
+		err = client.checkFlags("WhyEntityByRecordID_V2", flags)
+	}
+	result := client.stringResult(&client.WhyEntityByRecordID_V2Result)
+	if err == nil && client.WhyEntityByRecordID_V2Func != nil {
+		result, err = client.WhyEntityByRecordID_V2Func(ctx, dataSourceCode, recordID, flags)
+	}
+	result = client.mockMetadata("WhyEntityByRecordID_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8072, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(152, dataSourceCode, recordID, flags, client.WhyEntityByRecordID_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(152, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 	}
-	return client.WhyEntityByRecordID_V2Result, err
+	return result, err
 }
 
 /*
@@ -2689,26 +5552,39 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"INTERNAL_ID_2":2,"ENTITY_ID_2":2,"FOCUS_RECORDS_2":[{"DATA_SOURCE":"TEST","RECORD_ID":"222"}],"MATCH_INFO":{"WHY_KEY":"+PHONE+ACCT_NUM-DOB-SSN","WHY_ERRULE_CODE":"SF1","MATCH_LEVEL_CODE":"POSSIBLY_RELATED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}]},"DISCLOSED_RELATIONS":{},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":26,"CANDIDATE_FEAT":"772 Armstrong RD Delhi WI 53543","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":81,"SCORE_BUCKET":"LIKELY","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":25,"CANDIDATE_FEAT":"6/9/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":79,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":28,"CANDIDATE_FEAT":"flavorh2","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":24,"CANDIDATE_FEAT":"OCEANGUY","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":33,"GNR_SN":32,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":27,"CANDIDATE_FEAT":"153-33-5185","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:13:27.135","LAST_SEEN_DT":"2022-12-06 16:13:27.916"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.916","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:13:27.916","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.405","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.408","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.411","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.418","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.265","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.208","LAST_SEEN_DT":"2022-12-06 16:13:27.208"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.208"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.272","LAST_SEEN_DT":"2022-12-06 16:13:27.272"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.272"}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"FEAT_DESC_VALUES":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"FEAT_DESC_VALUES":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"FEAT_DESC_VALUES":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.208","LAST_SEEN_DT":"2022-12-06 16:13:27.208"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.208","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"222","ENTITY_TYPE":"TEST","INTERNAL_ID":2,"ENTITY_KEY":"740BA22D15CA88462A930AF8A7C904FF5E48226C","ENTITY_DESC":"OCEANGUY","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:13:27.208","FEATURES":[{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":24},{"LIB_FEAT_ID":25},{"LIB_FEAT_ID":26},{"LIB_FEAT_ID":27},{"LIB_FEAT_ID":28},{"LIB_FEAT_ID":29},{"LIB_FEAT_ID":30},{"LIB_FEAT_ID":31},{"LIB_FEAT_ID":32},{"LIB_FEAT_ID":33},{"LIB_FEAT_ID":34},{"LIB_FEAT_ID":35},{"LIB_FEAT_ID":36},{"LIB_FEAT_ID":37},{"LIB_FEAT_ID":38},{"LIB_FEAT_ID":39},{"LIB_FEAT_ID":40}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:13:27.135","LAST_SEEN_DT":"2022-12-06 16:13:27.916"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.916"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.272","LAST_SEEN_DT":"2022-12-06 16:13:27.272"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.272"}]}]}`
 */
 func (client *G2engine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(153, dataSourceCode1, recordID1, dataSourceCode2, recordID2)
 	}
+	client.Recorder.Record("g2engine", "WhyRecords", dataSourceCode1, recordID1, dataSourceCode2, recordID2)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyRecords"); err == nil {
+		defer client.finishCall("WhyRecords", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("WhyRecords", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	result := client.stringResult(&client.WhyRecordsResult)
+	if err == nil && client.WhyRecordsFunc != nil {
+		result, err = client.WhyRecordsFunc(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2)
+	}
+	result = client.mockMetadata("WhyRecords", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8073, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(154, dataSourceCode1, recordID1, dataSourceCode2, recordID2, client.WhyRecordsResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(154, dataSourceCode1, recordID1, dataSourceCode2, recordID2, result, err, time.Since(entryTime))
 	}
-	return client.WhyRecordsResult, err
+	return result, err
 }
 
 /*
@@ -2728,24 +5604,41 @@ Output
     See the example output.
 */
 func (client *G2engine) WhyRecords_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(155, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
 	}
+	client.Recorder.Record("g2engine", "WhyRecords_V2", dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
-		go func() {
+	if err = client.startCall(ctx, "WhyRecords_V2"); err == nil {
+		defer client.finishCall("WhyRecords_V2", entryTime)
+	}
+	if err == nil {
+		err = client.checkRecordAccess("WhyRecords_V2", recordKey{dataSourceCode1, recordID1}, recordKey{dataSourceCode2, recordID2})
+	}
+	client.recordFlags("WhyRecords_V2", flags)
+	if err == nil {
+		err = client.checkFlags("WhyRecords_V2", flags)
+	}
+	result := client.stringResult(&client.WhyRecords_V2Result)
+	if err == nil && client.WhyRecords_V2Func != nil {
+		result, err = client.WhyRecords_V2Func(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	}
+	result = client.mockMetadata("WhyRecords_V2", result)
+	if client.getObservers() != nil {
+		client.notifyAsync(func() {
 			details := map[string]string{
 				"dataSourceCode1": dataSourceCode1,
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
 			}
+			client.addPayload(details, "result", result)
 			client.notify(ctx, 8074, err, details)
-		}()
+		})
 	}
-	if client.isTrace {
-		defer client.traceExit(156, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags, client.WhyRecords_V2Result, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(156, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags, result, err, time.Since(entryTime))
 	}
-	return client.WhyRecords_V2Result, err
+	return result, err
 }
@@ -3,12 +3,25 @@ package g2engine
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
+	"github.com/senzing/g2-sdk-go-mock/iniparams"
+	"github.com/senzing/g2-sdk-go-mock/isolatedlogger"
+	"github.com/senzing/g2-sdk-go-mock/mockerror"
+	"github.com/senzing/g2-sdk-go-mock/notification"
+	"github.com/senzing/g2-sdk-go-mock/recorder"
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+	"github.com/senzing/g2-sdk-go-mock/resulthelpers"
+	"github.com/senzing/g2-sdk-go-mock/withinfo"
 	"github.com/senzing/g2-sdk-go/g2api"
 	"github.com/senzing/go-common/record"
 	"github.com/senzing/go-common/truthset"
@@ -22,6 +35,19 @@ const (
 	printResults      = false
 )
 
+type testObserver struct {
+	id       string
+	messages chan string
+}
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return observer.id
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	observer.messages <- message
+}
+
 type GetEntityByRecordIDResponse struct {
 	ResolvedEntity struct {
 		EntityId int64 `json:"ENTITY_ID"`
@@ -222,6 +248,89 @@ func TestG2engine_AddRecordWithInfo(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2engine_AddRecordWithInfo_dataSourceProfile(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		AddRecordWithInfoResult: `{"AFFECTED_ENTITIES":[]}`,
+	}
+	g2engine.SetDataSourceProfile("WATCHLIST", DataSourceProfile{
+		Latency:          50 * time.Millisecond,
+		WithInfoTemplate: `{"DATA_SOURCE":"{DATA_SOURCE}","RECORD_ID":"{RECORD_ID}","AFFECTED_ENTITIES":[{"ENTITY_ID":9}]}`,
+	})
+	g2engine.SetDataSourceProfile("REFERENCE", DataSourceProfile{
+		ErrorRate: 1.0,
+	})
+	entryTime := time.Now()
+	actual, err := g2engine.AddRecordWithInfo(ctx, "WATCHLIST", "1009", "{}", loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.GreaterOrEqual(test, time.Since(entryTime), 50*time.Millisecond)
+	assert.Equal(test, `{"DATA_SOURCE":"WATCHLIST","RECORD_ID":"1009","AFFECTED_ENTITIES":[{"ENTITY_ID":9}]}`, actual)
+	actual, err = g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1001", "{}", loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"AFFECTED_ENTITIES":[]}`, actual)
+	_, err = g2engine.AddRecordWithInfo(ctx, "REFERENCE", "2001", "{}", loadId, 0)
+	assert.Error(test, err)
+}
+
+func TestG2engine_AddRecord_dataSourceProfileAfterError(test *testing.T) {
+	ctx := context.TODO()
+	expected := errors.New("configured error")
+	g2engine := &G2engine{
+		Errors: map[string]error{"AddRecord": expected},
+	}
+	g2engine.SetDataSourceProfile("WATCHLIST", DataSourceProfile{
+		Latency:   time.Second,
+		ErrorRate: 1.0,
+	})
+	entryTime := time.Now()
+	err := g2engine.AddRecord(ctx, "WATCHLIST", "1009", "{}", loadId)
+	assert.ErrorIs(test, err, expected)
+	assert.Less(test, time.Since(entryTime), time.Second)
+}
+
+func TestG2engine_AddRecordWithInfo_withInfoSeed(test *testing.T) {
+	ctx := context.TODO()
+	results := [2][]string{}
+	for i := range results {
+		g2engine := &G2engine{}
+		g2engine.SetWithInfoSeed(42)
+		for recordNumber := 1; recordNumber <= 20; recordNumber++ {
+			actual, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", strconv.Itoa(recordNumber), "{}", loadId, 0)
+			testError(test, ctx, g2engine, err)
+			results[i] = append(results[i], actual)
+		}
+	}
+	assert.Equal(test, results[0], results[1])
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`, results[0][0])
+	merges := 0
+	for _, result := range results[0] {
+		document := withInfoJson{}
+		assert.NoError(test, json.Unmarshal([]byte(result), &document))
+		if len(document.AffectedEntities) > 1 {
+			merges++
+		}
+	}
+	assert.Greater(test, merges, 0)
+	assert.Less(test, merges, len(results[0]))
+}
+
+func TestG2engine_AddRecordWithInfo_withInfoSeedError(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.SetWithInfoSeed(42)
+	g2engine.SetCallPolicy("AddRecordWithInfo", "CUSTOMERS", "1", FailThenSucceed)
+	actual, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1", "{}", loadId, 0)
+	assert.Error(test, err)
+	assert.Empty(test, actual)
+	actual, err = g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1", "{}", loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`, actual)
+	g2engine.SetCallPolicy("DeleteRecordWithInfo", "CUSTOMERS", "1", FailThenSucceed)
+	actual, err = g2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1", loadId, 0)
+	assert.Error(test, err)
+	assert.Empty(test, actual)
+}
+
 func TestG2engine_AddRecordWithInfoWithReturnedRecordID(test *testing.T) {
 	ctx := context.TODO()
 	g2engine := getTestObject(ctx, test)
@@ -562,6 +671,21 @@ func TestG2engine_GetEntityByEntityID_V2(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2engine_GetEntityByEntityID_V2_flagsUsed(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	flags := int64(g2api.G2_ENTITY_INCLUDE_RECORD_DATA | g2api.G2_ENTITY_INCLUDE_ENTITY_NAME)
+	_, err := g2engine.GetEntityByEntityID_V2(ctx, 1, flags)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetEntityByEntityID_V2(ctx, 1, int64(g2api.G2_ENTITY_DEFAULT_FLAGS))
+	testError(test, ctx, g2engine, err)
+	actual := g2engine.FlagsUsed("GetEntityByEntityID_V2")
+	assert.Equal(test, []int64{flags, int64(g2api.G2_ENTITY_DEFAULT_FLAGS)}, actual)
+	assert.Equal(test, []string{"G2_ENTITY_INCLUDE_ENTITY_NAME", "G2_ENTITY_INCLUDE_RECORD_DATA"}, DecodeFlags(actual[0]))
+	assert.Contains(test, DecodeFlags(actual[1]), "G2_ENTITY_INCLUDE_RECORD_MATCHING_INFO")
+	assert.Empty(test, g2engine.FlagsUsed("WhyEntities_V2"))
+}
+
 func TestG2engine_GetEntityByRecordID(test *testing.T) {
 	ctx := context.TODO()
 	g2engine := getTestObject(ctx, test)
@@ -639,6 +763,91 @@ func TestG2engine_GetVirtualEntityByRecordID_V2(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2engine_InFlight(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency:        100 * time.Millisecond,
+		MaxConcurrentCalls: 2,
+	}
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+			done <- err
+		}()
+	}
+	assert.Eventually(test, func() bool {
+		return g2engine.InFlight()["GetRecord"] == 2
+	}, time.Second, 5*time.Millisecond)
+	_, err := g2engine.GetEntityByEntityID(ctx, 1)
+	assert.Error(test, err)
+	for i := 0; i < 2; i++ {
+		assert.NoError(test, <-done)
+	}
+	assert.Empty(test, g2engine.InFlight())
+}
+
+func TestG2engine_MaxQueuedCalls(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency:    100 * time.Millisecond,
+		MaxQueuedCalls: 2,
+	}
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+			done <- err
+		}()
+	}
+	assert.Eventually(test, func() bool {
+		return g2engine.InFlight()["GetRecord"] == 2
+	}, time.Second, 5*time.Millisecond)
+	_, err := g2engine.GetEntityByEntityID(ctx, 1)
+	assert.ErrorContains(test, err, "engine is busy")
+	for i := 0; i < 2; i++ {
+		assert.NoError(test, <-done)
+	}
+	_, err = g2engine.GetEntityByEntityID(ctx, 1)
+	assert.NoError(test, err)
+}
+
+func TestG2engine_ExportJSONEntityReportPages(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ExportLines: []string{`{"ENTITY_ID":1}`, `{"ENTITY_ID":2}`, `{"ENTITY_ID":3}`, `{"ENTITY_ID":4}`, `{"ENTITY_ID":5}`},
+	}
+	pages := g2engine.ExportJSONEntityReportPages(ctx, 0, 2, 1)
+	assert.Eventually(test, func() bool {
+		return len(pages) == cap(pages)
+	}, time.Second, 5*time.Millisecond)
+	actual := [][]string{}
+	for page := range pages {
+		actual = append(actual, page)
+	}
+	assert.Equal(test, [][]string{
+		{`{"ENTITY_ID":1}`, `{"ENTITY_ID":2}`},
+		{`{"ENTITY_ID":3}`, `{"ENTITY_ID":4}`},
+		{`{"ENTITY_ID":5}`},
+	}, actual)
+}
+
+func TestG2engine_ExportJSONEntityReportPages_cancel(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	g2engine := &G2engine{
+		ExportLines: []string{"1", "2", "3", "4"},
+	}
+	pages := g2engine.ExportJSONEntityReportPages(ctx, 0, 1, 0)
+	assert.Equal(test, []string{"1"}, <-pages)
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	remaining := 0
+	for range pages {
+		remaining++
+	}
+	assert.LessOrEqual(test, remaining, 1)
+}
+
 func TestG2engine_HowEntityByEntityID(test *testing.T) {
 	ctx := context.TODO()
 	g2engine := getTestObject(ctx, test)
@@ -665,6 +874,1872 @@ func TestG2engine_PrimeEngine(test *testing.T) {
 	testError(test, ctx, g2engine, err)
 }
 
+func TestG2engine_AddRecordWithInfo_canonicalJSON(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{CanonicalJSON: true}
+	g2engine.SetDataSourceProfile("CUSTOMERS", DataSourceProfile{
+		WithInfoTemplate: `{"RECORD_ID":"{RECORD_ID}","DATA_SOURCE":"{DATA_SOURCE}"}`,
+	})
+	actual, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1001", `{}`, "", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "{\n  \"DATA_SOURCE\": \"CUSTOMERS\",\n  \"RECORD_ID\": \"1001\"\n}", actual)
+}
+
+func TestG2engine_GetRecord_replicationLag(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetRecordResult:          `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`,
+		ReplicationLag:           50 * time.Millisecond,
+		SearchByAttributesResult: `{"RESOLVED_ENTITIES":[{"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1}}}]}`,
+	}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	assert.Error(test, err)
+	actual, err := g2engine.SearchByAttributes(ctx, `{}`)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RESOLVED_ENTITIES":[]}`, actual)
+	_, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1002")
+	assert.NoError(test, err)
+	assert.Eventually(test, func() bool {
+		_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+		return err == nil
+	}, time.Second, 5*time.Millisecond)
+	actual, err = g2engine.SearchByAttributes(ctx, `{}`)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.SearchByAttributesResult, actual)
+}
+
+func TestG2engine_AdvanceReplication(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{ReplicationLag: -1}
+	_, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1001", `{}`, "", 0)
+	testError(test, ctx, g2engine, err)
+	time.Sleep(10 * time.Millisecond)
+	_, err = g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001")
+	assert.Error(test, err)
+	g2engine.AdvanceReplication()
+	_, err = g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001")
+	assert.NoError(test, err)
+}
+
+func TestG2engine_GetEntityByEntityID_mockMetadata(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetEntityByEntityIDResult: `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`,
+		GetRecordResult:           `{}`,
+		InstanceID:                "mock-1",
+		MockMetadata:              true,
+		ScenarioName:              "three-entity",
+	}
+	actual, err := g2engine.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1},"_MOCK":{"CALL_SEQUENCE":1,"METHOD":"GetEntityByEntityID","INSTANCE_ID":"mock-1","SCENARIO_NAME":"three-entity"}}`, actual)
+	actual, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"_MOCK":{"CALL_SEQUENCE":2,"METHOD":"GetRecord","INSTANCE_ID":"mock-1","SCENARIO_NAME":"three-entity"}}`, actual)
+	g2engine.MockMetadata = false
+	actual, err = g2engine.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.GetEntityByEntityIDResult, actual)
+}
+
+func TestG2engine_RecordCount(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		AddRecordWithReturnedRecordIDResult: "GENERATED-1",
+		Stateful:                            true,
+	}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1002", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	err = g2engine.ReplaceRecord(ctx, "CUSTOMERS", "1002", `{"NAME_LAST":"Smith"}`, "")
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.AddRecordWithReturnedRecordID(ctx, "WATCHLIST", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 3, g2engine.RecordCount())
+	assert.Equal(test, 3, g2engine.EntityCount())
+	assert.Equal(test, map[string]int{"CUSTOMERS": 2, "WATCHLIST": 1}, g2engine.RecordCountByDataSource())
+	err = g2engine.DeleteRecord(ctx, "CUSTOMERS", "1001", "")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 2, g2engine.RecordCount())
+	assert.Equal(test, 2, g2engine.EntityCount())
+	err = g2engine.PurgeRepository(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 0, g2engine.RecordCount())
+	assert.Empty(test, g2engine.RecordCountByDataSource())
+}
+
+func TestG2engine_RecordCount_stateless(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 0, g2engine.RecordCount())
+}
+
+func TestG2engine_FindStoredRecords(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smith","ADDR_STATE":"LA"}`, "")
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1002", `{"NAME_LAST":"Jones","ADDRESSES":[{"ADDR_TYPE":"HOME","ADDR_STATE":"LA"}]}`, "")
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "WATCHLIST", "2001", `{"NAME_LAST":"Smith","ADDR_STATE":"NV"}`, "")
+	testError(test, ctx, g2engine, err)
+	actual := g2engine.FindStoredRecords(map[string]string{"ADDR_STATE": "LA"})
+	assert.Len(test, actual, 2)
+	assert.Equal(test, "1001", actual[0].RecordID)
+	assert.Equal(test, "1002", actual[1].RecordID)
+	actual = g2engine.FindStoredRecords(map[string]string{"DATA_SOURCE": "WATCHLIST", "NAME_LAST": "Smith"})
+	assert.Len(test, actual, 1)
+	assert.Equal(test, "2001", actual[0].RecordID)
+	assert.Empty(test, g2engine.FindStoredRecords(map[string]string{"ADDR_STATE": "TX"}))
+	assert.Len(test, g2engine.FindStoredRecords(nil), 3)
+}
+
+func TestG2engine_StoredRecordProvenance(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		Stateful:         true,
+		RecordProvenance: true,
+	}
+	_, ok := g2engine.StoredRecordProvenance("CUSTOMERS", "1001")
+	assert.False(test, ok)
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smith"}`, "LOAD-1")
+	testError(test, ctx, g2engine, err)
+	provenance, ok := g2engine.StoredRecordProvenance("CUSTOMERS", "1001")
+	assert.True(test, ok)
+	insertTime := provenance.InsertTime
+	assert.Equal(test, Provenance{LoadID: "LOAD-1", InsertTime: insertTime}, provenance)
+	for _, loadID := range []string{"LOAD-2", "LOAD-3"} {
+		err = g2engine.ReplaceRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smyth"}`, loadID)
+		testError(test, ctx, g2engine, err)
+	}
+	provenance, _ = g2engine.StoredRecordProvenance("CUSTOMERS", "1001")
+	assert.Equal(test, Provenance{LoadID: "LOAD-3", InsertTime: insertTime, ReplaceCount: 2}, provenance)
+	assert.Equal(test, 2, g2engine.FindStoredRecords(nil)[0].ReplaceCount)
+	actual, err := g2engine.GetRecord_V2(ctx, "CUSTOMERS", "1001", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"JSON_DATA":{"NAME_LAST":"Smyth"}`)
+	assert.Contains(test, actual, `"_PROVENANCE":{"LOAD_ID":"LOAD-3","INSERT_TIME":"`)
+	assert.Contains(test, actual, `"REPLACE_COUNT":2}`)
+}
+
+func TestG2engine_GetEntityByRecordID_stored(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	for _, record := range [][]string{{"CUSTOMERS", "1001"}, {"CUSTOMERS", "1002"}, {"WATCHLIST", "2001"}} {
+		err := g2engine.AddRecord(ctx, record[0], record[1], `{"NAME_FULL":"Robert Smith"}`, loadId)
+		testError(test, ctx, g2engine, err)
+	}
+	assert.True(test, g2engine.MergeRecords("CUSTOMERS", "1001", "CUSTOMERS", "1002"))
+	assert.True(test, g2engine.MergeRecords("CUSTOMERS", "1001", "WATCHLIST", "2001"))
+	assert.False(test, g2engine.MergeRecords("CUSTOMERS", "1001", "WATCHLIST", "9999"))
+	assert.Equal(test, 1, g2engine.EntityCount())
+	actual, err := g2engine.GetEntityByRecordID(ctx, "WATCHLIST", "2001")
+	testError(test, ctx, g2engine, err)
+	document := struct {
+		ResolvedEntity resultbuilder.ResolvedEntity `json:"RESOLVED_ENTITY"`
+	}{}
+	err = json.Unmarshal([]byte(actual), &document)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "Robert Smith", document.ResolvedEntity.EntityName)
+	assert.Len(test, document.ResolvedEntity.Records, 3)
+	assert.Len(test, document.ResolvedEntity.RecordSummary, 2)
+	assert.Equal(test, "CUSTOMERS", document.ResolvedEntity.RecordSummary[0].DataSource)
+	assert.Equal(test, 2, document.ResolvedEntity.RecordSummary[0].RecordCount)
+	assert.NotEmpty(test, document.ResolvedEntity.RecordSummary[0].FirstSeenDt)
+	assert.Equal(test, 1, document.ResolvedEntity.RecordSummary[1].RecordCount)
+	actual, err = g2engine.GetEntityByEntityID_V2(ctx, 1, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"RECORD_ID":"2001"`)
+	g2engine.GetEntityByEntityIDResult = `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`
+	actual, err = g2engine.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.GetEntityByEntityIDResult, actual)
+}
+
+func TestG2engine_GetEntityByRecordID_alias(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "CUST-1001", `{"NAME_FULL":"Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	g2engine.SetRecordAlias("CUSTOMERS", "1001", "CUST-1001", true)
+	actual, err := g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"RECORD_ID":"CUST-1001"`)
+	g2engine.SetRecordAlias("CUSTOMERS", "1001", "CUST-1001", false)
+	_, err = g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+	assert.ErrorContains(test, err, "alias of record[CUST-1001]")
+	_, err = g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "CUST-1001", 0)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_GetEntityByRecordID_storedReplaced(test *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2023, 1, 31, 12, 0, 0, 0, time.UTC)
+	resultbuilder.OverrideOptions(test, resultbuilder.Options{
+		Clock: func() time.Time { return now },
+	})
+	g2engine := &G2engine{Stateful: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	now = now.Add(24 * time.Hour)
+	err = g2engine.ReplaceRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Bob Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	actual, err := g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	document := struct {
+		ResolvedEntity resultbuilder.ResolvedEntity `json:"RESOLVED_ENTITY"`
+	}{}
+	err = json.Unmarshal([]byte(actual), &document)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, []resultbuilder.RecordSummary{
+		{DataSource: "CUSTOMERS", RecordCount: 1, FirstSeenDt: "2023-01-31 12:00:00.000", LastSeenDt: "2023-02-01 12:00:00.000"},
+	}, document.ResolvedEntity.RecordSummary)
+	assert.Equal(test, "2023-01-31 12:00:00.000", document.ResolvedEntity.Records[0].FirstSeenDt)
+}
+
+func TestG2engine_GetEntityByRecordID_storedPaged(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true, EntityPageSize: 2}
+	for _, recordID := range []string{"1001", "1002", "1003"} {
+		err := g2engine.AddRecord(ctx, "CUSTOMERS", recordID, `{"NAME_FULL":"Robert Smith"}`, loadId)
+		testError(test, ctx, g2engine, err)
+	}
+	g2engine.MergeRecords("CUSTOMERS", "1001", "CUSTOMERS", "1002")
+	g2engine.MergeRecords("CUSTOMERS", "1001", "CUSTOMERS", "1003")
+	actual, err := g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1003")
+	testError(test, ctx, g2engine, err)
+	document := struct {
+		ResolvedEntity resultbuilder.ResolvedEntity `json:"RESOLVED_ENTITY"`
+		RecordsPage    resultbuilder.RecordsPage    `json:"RECORDS_PAGE"`
+	}{}
+	err = json.Unmarshal([]byte(actual), &document)
+	testError(test, ctx, g2engine, err)
+	assert.Len(test, document.ResolvedEntity.Records, 2)
+	assert.Equal(test, 3, document.RecordsPage.TotalRecordCount)
+	assert.NotEmpty(test, document.RecordsPage.Continuation)
+}
+
+func TestG2engine_FindPathByEntityID_pathEdges(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 2, MatchLevel: 3})
+	g2engine.AddPathEdge(PathEdge{EntityID: 2, EntityID2: 4, MatchLevel: 3})
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 3, MatchLevel: 11, Weight: 0.5})
+	g2engine.AddPathEdge(PathEdge{EntityID: 3, EntityID2: 4, MatchLevel: 3, Weight: 0.5})
+	actual, err := g2engine.FindPathByEntityID(ctx, 1, 4, 2)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":4,"ENTITIES":[1,3,4]}]`)
+	g2engine.DisallowedPathMatchLevels = []int{11}
+	actual, err = g2engine.FindPathByEntityID(ctx, 1, 4, 2)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,2,4]`)
+	actual, err = g2engine.FindPathExcludingByEntityID(ctx, 1, 4, 2, `{"ENTITIES":[{"ENTITY_ID":2}]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[]`)
+	actual, err = g2engine.FindPathByEntityID(ctx, 1, 4, 1)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[]`)
+}
+
+func TestG2engine_FindPathByEntityID_relatedEntities(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 2, MatchLevel: 11, IsDisclosed: true})
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 3, MatchLevel: 2, IsAmbiguous: true})
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 4, MatchLevel: 3})
+	actual, err := g2engine.FindPathByEntityID(ctx, 1, 2, 1)
+	testError(test, ctx, g2engine, err)
+	document := struct {
+		Entities []struct {
+			RelatedEntities []resultbuilder.RelatedEntity `json:"RELATED_ENTITIES"`
+		} `json:"ENTITIES"`
+	}{}
+	err = json.Unmarshal([]byte(actual), &document)
+	testError(test, ctx, g2engine, err)
+	related := document.Entities[0].RelatedEntities
+	assert.Len(test, related, 3)
+	assert.Equal(test, []int{1, 0, 0}, []int{related[0].IsDisclosed, related[1].IsDisclosed, related[2].IsDisclosed})
+	assert.Equal(test, []int{0, 1, 0}, []int{related[0].IsAmbiguous, related[1].IsAmbiguous, related[2].IsAmbiguous})
+	assert.Equal(test, "DISCLOSED", related[0].MatchLevelCode)
+	assert.Equal(test, 1, document.Entities[1].RelatedEntities[0].IsDisclosed)
+}
+
+func TestG2engine_FindPathExcludingByRecordID_pathEdges(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		FindPathExcludingByRecordIDResult: `{"ENTITY_PATHS":[],"ENTITIES":[]}`,
+		Stateful:                          true,
+	}
+	for _, recordID := range []string{"1001", "1002", "1003"} {
+		err := g2engine.AddRecord(ctx, "CUSTOMERS", recordID, `{}`, "")
+		testError(test, ctx, g2engine, err)
+	}
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 2})
+	g2engine.AddPathEdge(PathEdge{EntityID: 2, EntityID2: 3})
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 3, Weight: 5})
+	actual, err := g2engine.FindPathExcludingByRecordID(ctx, "CUSTOMERS", "1001", "CUSTOMERS", "1003", 2, `{"RECORDS":[]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,2,3]`)
+	actual, err = g2engine.FindPathExcludingByRecordID(ctx, "CUSTOMERS", "1001", "CUSTOMERS", "1003", 2, `{"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,3]`)
+	actual, err = g2engine.FindPathExcludingByRecordID(ctx, "CUSTOMERS", "1001", "CUSTOMERS", "9999", 2, `{"RECORDS":[]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.FindPathExcludingByRecordIDResult, actual)
+}
+
+func TestG2engine_FindPathIncludingSourceByEntityID_requiredDsrcs(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	records := [][]string{{"CUSTOMERS", "1001"}, {"CUSTOMERS", "1002"}, {"WATCHLIST", "1003"}}
+	for _, record := range records {
+		err := g2engine.AddRecord(ctx, record[0], record[1], `{}`, "")
+		testError(test, ctx, g2engine, err)
+	}
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 3})
+	g2engine.AddPathEdge(PathEdge{EntityID: 3, EntityID2: 2})
+	actual, err := g2engine.FindPathIncludingSourceByEntityID(ctx, 1, 2, 2, `{"ENTITIES":[]}`, `{"DATA_SOURCES":["WATCHLIST"]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,3,2]`)
+	actual, err = g2engine.FindPathIncludingSourceByEntityID(ctx, 1, 2, 2, `{"ENTITIES":[]}`, `{"DATA_SOURCES":["REFERENCE"]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[]`)
+	actual, err = g2engine.FindPathIncludingSourceByRecordID(ctx, "CUSTOMERS", "1001", "CUSTOMERS", "1002", 2, `{"RECORDS":[]}`, `{"DATA_SOURCES":["WATCHLIST"]}`)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,3,2]`)
+}
+
+func TestG2engine_FindPathExcludingByEntityID_V2_preferExclude(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 2})
+	g2engine.AddPathEdge(PathEdge{EntityID: 2, EntityID2: 3})
+	excludedEntities := `{"ENTITIES":[{"ENTITY_ID":2}]}`
+	actual, err := g2engine.FindPathExcludingByEntityID_V2(ctx, 1, 3, 2, excludedEntities, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[]`)
+	actual, err = g2engine.FindPathExcludingByEntityID_V2(ctx, 1, 3, 2, excludedEntities, int64(g2api.G2_FIND_PATH_PREFER_EXCLUDE))
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,2,3]`)
+	g2engine.AddPathEdge(PathEdge{EntityID: 1, EntityID2: 3, Weight: 10})
+	actual, err = g2engine.FindPathExcludingByEntityID_V2(ctx, 1, 3, 2, excludedEntities, int64(g2api.G2_FIND_PATH_PREFER_EXCLUDE))
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITIES":[1,3]`)
+}
+
+func TestG2engine_RecordFeatures(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	jsonData := `{"NAME_FIRST":"Robert","NAME_LAST":"Smith","PHONE_NUMBER":"+1 (702) 919-1300","EMAIL_ADDRESS":"Bsmith@work.com","ADDR_LINE1":"123 Main Street","ADDR_CITY":"Las Vegas","NAMES":[{"NAME_TYPE":"AKA","NAME_FULL":"Bob Smith"}]}`
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", jsonData, "")
+	testError(test, ctx, g2engine, err)
+	actual, ok := g2engine.RecordFeatures("CUSTOMERS", "1001")
+	assert.True(test, ok)
+	assert.Equal(test, []string{"Bob Smith", "Robert Smith"}, actual["NAME"])
+	assert.Equal(test, []string{"BOB|SMITH", "ROBERT|SMITH"}, actual["NAME_KEY"])
+	assert.Equal(test, []string{"7029191300"}, actual["PHONE_KEY"])
+	assert.Equal(test, []string{"bsmith@work.com"}, actual["EMAIL_KEY"])
+	assert.Equal(test, []string{"123 Main Street Las Vegas"}, actual["ADDRESS"])
+	_, ok = g2engine.RecordFeatures("CUSTOMERS", "9999")
+	assert.False(test, ok)
+}
+
+func TestG2engine_FindInterestingEntitiesByEntityID_rules(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "WATCHLIST", "1002", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	g2engine.AddInterestingEntityRule(InterestingEntityRule{EntityID: 1, InterestingEntityID: 7, Degrees: 2, Flags: []string{"SAME_ADDRESS"}})
+	g2engine.AddInterestingEntityRule(InterestingEntityRule{DataSourceCode: "WATCHLIST", InterestingEntityID: 9, Flags: []string{"WATCHLIST"}})
+	actual, err := g2engine.FindInterestingEntitiesByEntityID(ctx, 1, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"INTERESTING_ENTITIES":{"ENTITIES":[{"ENTITY_ID":7,"DEGREES":2,"FLAGS":["SAME_ADDRESS"],"SAMPLE_RECORDS":[]}]}}`, actual)
+	actual, err = g2engine.FindInterestingEntitiesByEntityID(ctx, 2, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"INTERESTING_ENTITIES":{"ENTITIES":[{"ENTITY_ID":9,"DEGREES":1,"FLAGS":["WATCHLIST"],"SAMPLE_RECORDS":[]}]}}`, actual)
+	actual, err = g2engine.FindInterestingEntitiesByRecordID(ctx, "WATCHLIST", "9999", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITY_ID":9`)
+	actual, err = g2engine.FindInterestingEntitiesByRecordID(ctx, "CUSTOMERS", "1001", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, `"ENTITY_ID":7`)
+	assert.NotContains(test, actual, `"ENTITY_ID":9`)
+}
+
+func TestG2engine_PurgeProgress(test *testing.T) {
+	ctx := context.TODO()
+	var mutex sync.Mutex
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	g2engine := &G2engine{
+		PurgeDuration: 100 * time.Millisecond,
+		Clock: func() time.Time {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return now
+		},
+	}
+	_, ok := g2engine.PurgeProgress()
+	assert.False(test, ok)
+	purged := make(chan error, 1)
+	go func() {
+		purged <- g2engine.PurgeRepository(ctx)
+	}()
+	assert.Eventually(test, func() bool {
+		_, ok := g2engine.PurgeProgress()
+		return ok
+	}, time.Second, time.Millisecond)
+	mutex.Lock()
+	now = start.Add(25 * time.Millisecond)
+	mutex.Unlock()
+	progress, _ := g2engine.PurgeProgress()
+	assert.Equal(test, Progress{PercentComplete: 25, ETA: start.Add(100 * time.Millisecond)}, progress)
+	assert.NoError(test, <-purged)
+	progress, _ = g2engine.PurgeProgress()
+	assert.Equal(test, Progress{PercentComplete: 100, ETA: start.Add(100 * time.Millisecond), Done: true}, progress)
+}
+
+func TestG2engine_PurgeRepository_progress(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		PurgeDuration:         100 * time.Millisecond,
+		PurgeProgressInterval: 20 * time.Millisecond,
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 100),
+	}
+	err := g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	purged := make(chan time.Time, 1)
+	go func() {
+		err := g2engine.PurgeRepository(ctx)
+		testError(test, ctx, g2engine, err)
+		purged <- time.Now()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	added := time.Now()
+	assert.False(test, added.Before(<-purged))
+	progress := 0
+	for len(observer.messages) > 0 {
+		details := map[string]string{}
+		err = json.Unmarshal([]byte(<-observer.messages), &details)
+		testError(test, ctx, g2engine, err)
+		if details["messageId"] == "8901" {
+			assert.NotEmpty(test, details["percentComplete"])
+			progress++
+		}
+	}
+	assert.Greater(test, progress, 0)
+}
+
+func TestG2engine_AddRecordWithInfoWithReturnedRecordID_hooks(test *testing.T) {
+	ctx := context.TODO()
+	jsonData := `{"NAME_FULL":"Robert Smith"}`
+	recordID := resultbuilder.RecordID("CUSTOMERS", jsonData)
+	g2engine := &G2engine{
+		MockMetadata: true,
+		AddRecordWithInfoFunc: func(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+			return fmt.Sprintf(`{"DATA_SOURCE":"%s","RECORD_ID":"%s"}`, dataSourceCode, recordID), nil
+		},
+	}
+	g2engine.SetCallPolicy("AddRecordWithInfoWithReturnedRecordID", "CUSTOMERS", recordID, FailThenSucceed)
+	_, _, err := g2engine.AddRecordWithInfoWithReturnedRecordID(ctx, "CUSTOMERS", jsonData, loadId, 0)
+	assert.Error(test, err)
+	actual, actualRecordID, err := g2engine.AddRecordWithInfoWithReturnedRecordID(ctx, "CUSTOMERS", jsonData, loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, recordID, actualRecordID)
+	assert.Contains(test, actual, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"`+recordID+`",`)
+	assert.Contains(test, actual, `"METHOD":"AddRecordWithInfoWithReturnedRecordID"`)
+}
+
+func TestG2engine_AddRecordWithReturnedRecordID_callPolicy(test *testing.T) {
+	ctx := context.TODO()
+	jsonData := `{"NAME_FULL":"Robert Smith"}`
+	recordID := resultbuilder.RecordID("CUSTOMERS", jsonData)
+	g2engine := &G2engine{Stateful: true}
+	g2engine.SetCallPolicy("AddRecordWithReturnedRecordID", "CUSTOMERS", recordID, FailThenSucceed)
+	actualRecordID, err := g2engine.AddRecordWithReturnedRecordID(ctx, "CUSTOMERS", jsonData, loadId)
+	assert.Error(test, err)
+	assert.Empty(test, actualRecordID)
+	_, err = g2engine.GetRecord(ctx, "CUSTOMERS", recordID)
+	assert.Error(test, err)
+	actualRecordID, err = g2engine.AddRecordWithReturnedRecordID(ctx, "CUSTOMERS", jsonData, loadId)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, recordID, actualRecordID)
+	_, err = g2engine.GetRecord(ctx, "CUSTOMERS", recordID)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_RecordIDGenerator(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	recordID1, err := g2engine.AddRecordWithReturnedRecordID(ctx, "CUSTOMERS", `{"NAME_FULL":"Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, resultbuilder.RecordID("CUSTOMERS", `{"NAME_FULL":"Robert Smith"}`), recordID1)
+	_, recordID2, err := g2engine.AddRecordWithInfoWithReturnedRecordID(ctx, "CUSTOMERS", `{"NAME_FULL":"Mary Jones"}`, loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.NotEqual(test, recordID1, recordID2)
+	again, err := g2engine.AddRecordWithReturnedRecordID(ctx, "CUSTOMERS", `{"NAME_FULL":"Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, recordID1, again)
+	assert.Equal(test, 2, g2engine.RecordCount())
+
+	g2engine.AddRecordWithReturnedRecordIDResult = "PRESET"
+	actual, err := g2engine.AddRecordWithReturnedRecordID(ctx, "CUSTOMERS", `{"NAME_FULL":"Bob Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "PRESET", actual)
+
+	sequence := 0
+	g2engine.RecordIDGenerator = func(dataSourceCode string, jsonData string) string {
+		sequence++
+		return fmt.Sprintf("%s-%d", dataSourceCode, sequence)
+	}
+	for _, expected := range []string{"CUSTOMERS-1", "CUSTOMERS-2"} {
+		actual, err = g2engine.AddRecordWithReturnedRecordID(ctx, "CUSTOMERS", `{}`, loadId)
+		testError(test, ctx, g2engine, err)
+		assert.Equal(test, expected, actual)
+	}
+}
+
+func TestG2engine_storedWithInfo(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	g2engine.AddInterestingEntityRule(InterestingEntityRule{DataSourceCode: "WATCHLIST", InterestingEntityID: 9, Flags: []string{"WATCHLIST"}})
+	actual, err := g2engine.AddRecordWithInfo(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.JSONEq(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`, actual)
+	actual, err = g2engine.AddRecordWithInfo(ctx, "WATCHLIST", "2001", `{"NAME_FULL":"Bob Smith"}`, loadId, 0)
+	testError(test, ctx, g2engine, err)
+	withinfo.AssertAffected(test, actual, 2)
+	withinfo.AssertInteresting(test, actual, 9)
+	actual, err = g2engine.ReevaluateRecordWithInfo(ctx, "CUSTOMERS", "1001", 0)
+	testError(test, ctx, g2engine, err)
+	withinfo.AssertAffected(test, actual, 1)
+	actual, err = g2engine.DeleteRecordWithInfo(ctx, "WATCHLIST", "2001", loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"DATA_SOURCE":"WATCHLIST","RECORD_ID":"2001","AFFECTED_ENTITIES":[{"ENTITY_ID":2}],"INTERESTING_ENTITIES":{"ENTITIES":[{"ENTITY_ID":9,"DEGREES":1,"FLAGS":["WATCHLIST"],"SAMPLE_RECORDS":[]}]}}`, actual)
+	g2engine.DeleteRecordWithInfoResult = `{"AFFECTED_ENTITIES":[]}`
+	actual, err = g2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1001", loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"AFFECTED_ENTITIES":[]}`, actual)
+}
+
+func TestG2engine_FeatureChanges(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true, FeatureChanges: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith","PHONE_NUMBER":"702-919-1300"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	actual, err := g2engine.ReplaceRecordWithInfo(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith","EMAIL_ADDRESS":"bsmith@work.com"}`, loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.JSONEq(test, `{
+		"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001",
+		"AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]},
+		"REPLACE_COUNT":1,
+		"ADDED_FEATURES":[{"FEAT_TYPE":"EMAIL","FEAT_DESC":"bsmith@work.com"}],
+		"REMOVED_FEATURES":[{"FEAT_TYPE":"PHONE","FEAT_DESC":"702-919-1300"}]
+	}`, actual)
+	actual, err = g2engine.ReplaceRecordWithInfo(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Bob Smith","EMAIL_ADDRESS":"bsmith@work.com"}`, loadId, 0)
+	testError(test, ctx, g2engine, err)
+	assert.JSONEq(test, `{
+		"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001",
+		"AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]},
+		"REPLACE_COUNT":2,
+		"ADDED_FEATURES":[{"FEAT_TYPE":"NAME","FEAT_DESC":"Bob Smith"}],
+		"REMOVED_FEATURES":[{"FEAT_TYPE":"NAME","FEAT_DESC":"Robert Smith"}]
+	}`, actual)
+}
+
+func TestG2engine_ScheduleFault(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	scheduledErr := errors.New("disk full")
+	g2engine.ScheduleFault("AddRecord", FaultSchedule{Call: 3, Failures: 2, Err: scheduledErr})
+	for call := 1; call <= 6; call++ {
+		err := g2engine.AddRecord(ctx, "CUSTOMERS", strconv.Itoa(call), `{}`, loadId)
+		if call == 3 || call == 4 {
+			assert.Equal(test, scheduledErr, err, "call %d", call)
+		} else {
+			assert.NoError(test, err, "call %d", call)
+		}
+	}
+
+	g2engine.ScheduleFault("AddRecord", FaultSchedule{Call: 2})
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+	testError(test, ctx, g2engine, err)
+	for call := 2; call <= 4; call++ {
+		err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+		assert.Equal(test, 4923, mockerror.Code(err), "call %d", call)
+	}
+	err = g2engine.DeleteRecord(ctx, "CUSTOMERS", "1001", loadId)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_SetCallPolicy_succeedThenFail(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.SetCallPolicy("AddRecord", "CUSTOMERS", "1001", SucceedThenFail)
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`, loadId)
+	assert.ErrorContains(test, err, "Duplicate record")
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1002", `{"RECORD_ID":"1002"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.ReplaceRecord(ctx, "CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`, loadId)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_SetCallPolicy_failThenSucceed(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.SetCallPolicy("DeleteRecordWithInfo", "CUSTOMERS", "1001", FailThenSucceed)
+	_, err := g2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1001", loadId, 0)
+	assert.ErrorContains(test, err, "Duplicate record")
+	_, err = g2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1001", loadId, 0)
+	testError(test, ctx, g2engine, err)
+	g2engine.SetCallPolicy("DeleteRecordWithInfo", "CUSTOMERS", "1001", FailThenSucceed)
+	_, err = g2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1001", loadId, 0)
+	assert.Error(test, err)
+}
+
+func TestG2engine_WithScope(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetRecordResult: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`,
+	}
+	g2engine.WithScope(func(scoped *G2engine) {
+		scoped.GetRecordResult = `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}`
+		scoped.SetDataSourceProfile("CUSTOMERS", DataSourceProfile{ErrorRate: 1})
+		scoped.WithScope(func(nested *G2engine) {
+			nested.SetDataSourceProfile("CUSTOMERS", DataSourceProfile{})
+			actual, err := nested.GetRecord(ctx, "CUSTOMERS", "1002")
+			testError(test, ctx, g2engine, err)
+			assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}`, actual)
+		})
+		_, err := scoped.GetRecord(ctx, "CUSTOMERS", "1002")
+		assert.Error(test, err)
+	})
+	actual, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`, actual)
+}
+
+func TestG2engine_WithScope_maps(test *testing.T) {
+	ctx := context.TODO()
+	expected := errors.New("configured error")
+	g2engine := &G2engine{
+		Errors: map[string]error{"DeleteRecord": expected},
+	}
+	g2engine.WithScope(func(scoped *G2engine) {
+		scoped.Errors["AddRecord"] = errors.New("boom")
+		err := scoped.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+		assert.ErrorContains(test, err, "boom")
+	})
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.DeleteRecord(ctx, "CUSTOMERS", "1001", loadId)
+	assert.ErrorIs(test, err, expected)
+}
+
+func TestG2engine_WithScope_latency(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.WithScope(func(scoped *G2engine) {
+		scoped.SetLatency("GetActiveConfigID", 50*time.Millisecond)
+		entryTime := time.Now()
+		_, err := scoped.GetActiveConfigID(ctx)
+		testError(test, ctx, scoped, err)
+		assert.GreaterOrEqual(test, time.Since(entryTime), 50*time.Millisecond)
+	})
+	entryTime := time.Now()
+	_, err := g2engine.GetActiveConfigID(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Less(test, time.Since(entryTime), 50*time.Millisecond)
+}
+
+func TestG2engine_Init_environment(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetEntityByEntityIDResult: `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`,
+	}
+	fixtureDir := test.TempDir()
+	err := os.WriteFile(fixtureDir+"/GetRecordResult.json", []byte(`{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`+"\n"), 0644)
+	testError(test, ctx, g2engine, err)
+	test.Setenv(EnvCallLatency, "5ms")
+	test.Setenv(EnvChaosProfile, `{"WATCHLIST":{"ERROR_RATE":1}}`)
+	test.Setenv(EnvFixtureDir, fixtureDir)
+	test.Setenv(EnvLogLevel, "warn")
+	err = g2engine.Init(ctx, "Test module name", "{}", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 5*time.Millisecond, g2engine.CallLatency)
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`, g2engine.GetRecordResult)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`, g2engine.GetEntityByEntityIDResult)
+	_, err = g2engine.GetRecord(ctx, "WATCHLIST", "1001")
+	assert.Error(test, err)
+}
+
+func TestG2engine_Init_environmentInvalid(test *testing.T) {
+	ctx := context.TODO()
+	test.Setenv(EnvCallLatency, "soon")
+	g2engine := &G2engine{}
+	err := g2engine.Init(ctx, "Test module name", "{}", 0)
+	assert.ErrorContains(test, err, EnvCallLatency)
+}
+
+func TestG2engine_NotificationEncoder(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		NotificationEncoder: notification.CloudEvents,
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	event := struct {
+		SpecVersion string            `json:"specversion"`
+		Type        string            `json:"type"`
+		Data        map[string]string `json:"data"`
+	}{}
+	// The notification of RegisterObserver() may be delivered after that of AddRecord().
+	timeout := time.After(time.Second)
+	for event.Type != "com.senzing.notification.8001" {
+		select {
+		case message := <-observer.messages:
+			err = json.Unmarshal([]byte(message), &event)
+			testError(test, ctx, g2engine, err)
+		case <-timeout:
+			assert.FailNow(test, "No notification received")
+		}
+	}
+	assert.Equal(test, "1.0", event.SpecVersion)
+	assert.Equal(test, "1001", event.Data["recordID"])
+}
+
+func TestG2engine_ObserverFaults_log(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ObserverFaults:      ObserverFaults{ErrorRate: 1},
+		NotifyFailurePolicy: NotifyLog,
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	g2engine.notify(ctx, 8001, nil, map[string]string{})
+	assert.Eventually(test, func() bool {
+		return len(g2engine.DroppedNotifications()) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Empty(test, observer.messages)
+}
+
+func TestG2engine_ObserverFaults_retry(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ObserverFaults:      ObserverFaults{Block: 50 * time.Millisecond},
+		NotifyFailurePolicy: NotifyRetry,
+		NotifyRetries:       2,
+		NotifyTimeout:       10 * time.Millisecond,
+	}
+	startTime := time.Now()
+	g2engine.notify(ctx, 8001, nil, map[string]string{})
+	assert.GreaterOrEqual(test, time.Since(startTime), 30*time.Millisecond)
+	dropped := g2engine.DroppedNotifications()
+	assert.Len(test, dropped, 1)
+	assert.Contains(test, dropped[0], `"messageId":"8001"`)
+}
+
+func TestG2engine_UnregisterObserver_empty(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2engine.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_NotificationEncoder_error(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		NotificationEncoder: func(details map[string]string) (string, error) {
+			return "", fmt.Errorf("cannot encode %s", details["messageId"])
+		},
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	assert.NotPanics(test, func() {
+		g2engine.notify(ctx, 8001, nil, map[string]string{})
+	})
+	assert.Empty(test, observer.messages)
+}
+
+func TestG2engine_GetRepositoryLastModifiedTime_dataSources(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetRepositoryLastModifiedTimeResult: 1000,
+	}
+	g2engine.SetDataSourceLastModifiedTime("CUSTOMERS", 3000)
+	g2engine.SetDataSourceLastModifiedTime("WATCHLIST", 2000)
+	actual, err := g2engine.GetRepositoryLastModifiedTime(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, int64(3000), actual)
+	lastModifiedTime, ok := g2engine.DataSourceLastModifiedTime("WATCHLIST")
+	assert.True(test, ok)
+	assert.Equal(test, int64(2000), lastModifiedTime)
+	_, ok = g2engine.DataSourceLastModifiedTime("REFERENCE")
+	assert.False(test, ok)
+}
+
+func TestG2engine_Init_configCompatibility(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ConfigCompatibilityVersion: "10",
+		ExportConfigResult:         `{"G2_CONFIG":{"CONFIG_BASE_VERSION":{"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"}}}}`,
+		Configs: map[int64]string{
+			2: `{"G2_CONFIG":{"CONFIG_BASE_VERSION":{"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"9"}}}}`,
+		},
+	}
+	err := g2engine.Init(ctx, "Test module name", "{}", 0)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.Reinit(ctx, 1)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.Reinit(ctx, 2)
+	assert.ErrorContains(test, err, "compatibility version mismatch")
+	err = g2engine.InitWithConfigID(ctx, "Test module name", "{}", 2, 0)
+	assert.ErrorContains(test, err, "found [9], expected [10]")
+}
+
+func TestG2engine_AddRecordFromReader(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		Stateful: true,
+	}
+	err := g2engine.AddRecordFromReader(ctx, "CUSTOMERS", "1001", strings.NewReader(`{"RECORD_ID":"1001"}`), loadId)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecordFromRawMessage(ctx, "CUSTOMERS", "1002", json.RawMessage(`{"RECORD_ID":"1002"}`), loadId)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 2, g2engine.RecordCount())
+}
+
+func TestG2engine_AddRecordsFromReader(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		Stateful: true,
+	}
+	reader := strings.NewReader(`{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}
+{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}
+{"DATA_SOURCE":"WATCHLIST","RECORD_ID":"1003"}
+`)
+	actual, err := g2engine.AddRecordsFromReader(ctx, reader, loadId)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, 3, actual)
+	assert.Equal(test, map[string]int{"CUSTOMERS": 2, "WATCHLIST": 1}, g2engine.RecordCountByDataSource())
+	_, err = g2engine.AddRecordsFromReader(ctx, strings.NewReader(`{"DATA_SOURCE":`), loadId)
+	assert.Error(test, err)
+}
+
+func TestG2engine_RunScenario(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ScenarioName: "Load customers",
+	}
+	g2engine.RunScenario(
+		ScenarioStep{Method: "AddRecord", Description: "load 1001"},
+		ScenarioStep{Method: "AddRecord", Description: "load 1002"},
+		ScenarioStep{Method: "GetEntityByRecordID", Description: "check 1001"},
+	)
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	progress := g2engine.ScenarioProgress()
+	assert.Equal(test, 1, progress.CurrentStep)
+	assert.Len(test, progress.Satisfied, 1)
+	assert.Len(test, progress.Remaining, 2)
+	assert.Equal(test, "step 2 of 3: AddRecord (load 1002)", progress.String())
+	_, err = g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001")
+	assert.ErrorContains(test, err, "expected AddRecord (load 1002)")
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1002", `{"RECORD_ID":"1002"}`, loadId)
+	assert.Error(test, err)
+	assert.Equal(test, "diverged: call to GetEntityByRecordID at step 2 of 3, expected AddRecord (load 1002)", g2engine.ScenarioProgress().String())
+}
+
+func TestG2engine_RunScenario_complete(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.RunScenario(ScenarioStep{Method: "CountRedoRecords"})
+	_, err := g2engine.CountRedoRecords(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "complete: 1 steps", g2engine.ScenarioProgress().String())
+	_, err = g2engine.CountRedoRecords(ctx)
+	assert.ErrorContains(test, err, "after the last step")
+}
+
+func TestG2engine_GetEntityByEntityID_compressed(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetEntityByEntityIDResult: `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`,
+		CompressionThreshold:      10,
+		GetRecordResult:           `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`,
+	}
+	actual, err := g2engine.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, g2engine, err)
+	assert.True(test, strings.HasPrefix(actual, resulthelpers.CompressedPrefix))
+	document, err := resulthelpers.Decompress(actual)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`, document)
+	actual, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`, actual)
+}
+
+func TestG2engine_SimulateRestart(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ExportJSONEntityReportResult: 1,
+		ColdStartCalls:               2,
+		ColdStartFactor:              2,
+		Stateful:                     true,
+	}
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "")
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetRecord_V2(ctx, "CUSTOMERS", "1001", 1)
+	testError(test, ctx, g2engine, err)
+	g2engine.SimulateRestart()
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	assert.Error(test, err)
+	err = g2engine.CloseExport(ctx, responseHandle)
+	assert.Error(test, err)
+	assert.Empty(test, g2engine.FlagsUsed("GetRecord_V2"))
+	assert.Equal(test, 1, g2engine.RecordCount())
+	responseHandle, err = g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.CloseExport(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_EnqueueFetchNextResult(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{ExportJSONEntityReportResult: 1}
+	g2engine.EnqueueFetchNextResult(`{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`, `{"RESOLVED_ENTITY":{"ENTITY_ID":2}}`, "")
+	assert.Equal(test, 3, g2engine.QueuedResultCount("FetchNext"))
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	chunks := []string{}
+	for {
+		chunk, err := g2engine.FetchNext(ctx, responseHandle)
+		testError(test, ctx, g2engine, err)
+		if len(chunk) == 0 {
+			break
+		}
+		chunks = append(chunks, chunk)
+	}
+	assert.Equal(test, []string{`{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`, `{"RESOLVED_ENTITY":{"ENTITY_ID":2}}`}, chunks)
+	assert.Equal(test, 0, g2engine.QueuedResultCount("FetchNext"))
+	err = g2engine.CloseExport(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_EnqueueGetRedoRecordResult(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.EnqueueGetRedoRecordResult(`{"REASON":"1"}`, "")
+	actual, err := g2engine.GetRedoRecord(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"REASON":"1"}`, actual)
+	actual, err = g2engine.GetRedoRecord(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Empty(test, actual)
+}
+
+func TestG2engine_PushRedoRecord(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CountRedoRecordsResult:  5,
+		GetRedoRecordResult:     `{"REASON":"static"}`,
+		ProcessRedoRecordResult: `{"REASON":"static"}`,
+	}
+	g2engine.PushRedoRecord(`{"REASON":"1"}`, `{"REASON":"2"}`)
+	g2engine.PushRedoRecord(`{"REASON":"3"}`)
+	processed := []string{}
+	for {
+		count, err := g2engine.CountRedoRecords(ctx)
+		testError(test, ctx, g2engine, err)
+		assert.Equal(test, int64(3-len(processed)), count)
+		redoRecord, err := g2engine.GetRedoRecord(ctx)
+		testError(test, ctx, g2engine, err)
+		if len(redoRecord) == 0 {
+			break
+		}
+		processed = append(processed, redoRecord)
+	}
+	assert.Equal(test, []string{`{"REASON":"1"}`, `{"REASON":"2"}`, `{"REASON":"3"}`}, processed)
+	actual, err := g2engine.ProcessRedoRecord(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Empty(test, actual)
+	g2engine.PushRedoRecord(`{"REASON":"4"}`)
+	actual, withInfo, err := g2engine.ProcessRedoRecordWithInfo(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"REASON":"4"}`, actual)
+	assert.Empty(test, withInfo)
+	assert.Equal(test, int64(0), g2engine.RedoRecordCount())
+}
+
+func TestG2engine_ExportPages(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{ExportPages: []string{"page1", "page2"}}
+	responseHandle1, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	responseHandle2, err := g2engine.ExportCSVEntityReport(ctx, "RESOLVED_ENTITY_ID", 0)
+	testError(test, ctx, g2engine, err)
+	assert.NotEqual(test, responseHandle1, responseHandle2)
+	for _, expected := range []string{"page1", "page2", "", ""} {
+		actual, err := g2engine.FetchNext(ctx, responseHandle1)
+		testError(test, ctx, g2engine, err)
+		assert.Equal(test, expected, actual)
+	}
+	actual, err := g2engine.FetchNext(ctx, responseHandle2)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "page1", actual)
+	err = g2engine.CloseExport(ctx, responseHandle1)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.FetchNext(ctx, responseHandle1)
+	assert.ErrorContains(test, err, "is closed")
+	err = g2engine.CloseExport(ctx, responseHandle1)
+	assert.ErrorContains(test, err, "is closed")
+	actual, err = g2engine.FetchNext(ctx, responseHandle2)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "page2", actual)
+	err = g2engine.CloseExport(ctx, responseHandle2)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_ExportProgress(test *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	g2engine := &G2engine{
+		ExportPages: []string{"page1", "page2", "page3", "page4"},
+		Clock:       func() time.Time { return now },
+	}
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	progress, ok := g2engine.ExportProgress(responseHandle)
+	assert.True(test, ok)
+	assert.Equal(test, Progress{}, progress)
+	now = now.Add(time.Second)
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+	progress, _ = g2engine.ExportProgress(responseHandle)
+	assert.Equal(test, Progress{PercentComplete: 25, ETA: now.Add(3 * time.Second)}, progress)
+	for i := 0; i < 3; i++ {
+		_, err = g2engine.FetchNext(ctx, responseHandle)
+		testError(test, ctx, g2engine, err)
+	}
+	progress, _ = g2engine.ExportProgress(responseHandle)
+	assert.True(test, progress.Done)
+	err = g2engine.CloseExport(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+	_, ok = g2engine.ExportProgress(responseHandle)
+	assert.False(test, ok)
+}
+
+func TestG2engine_FetchNext_handleExpiration(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ExportJSONEntityReportResult: 1,
+		HandleMaxFetches:             2,
+	}
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	for i := 0; i < 2; i++ {
+		_, err = g2engine.FetchNext(ctx, responseHandle)
+		testError(test, ctx, g2engine, err)
+	}
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	assert.ErrorContains(test, err, "expired")
+	err = g2engine.CloseExport(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+	g2engine.HandleMaxFetches = 0
+	g2engine.HandleTTL = 10 * time.Millisecond
+	responseHandle, err = g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	testError(test, ctx, g2engine, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = g2engine.FetchNext(ctx, responseHandle)
+	assert.ErrorContains(test, err, "expired")
+}
+
+func TestG2engine_NotificationVerbosity(test *testing.T) {
+	ctx := context.TODO()
+	for verbosity, expected := range map[NotificationVerbosity][]string{
+		VerbosityIDs:     {"dataSourceCode", "loadID", "messageId", "messageTime", "recordID", "subjectId"},
+		VerbositySummary: {"dataSourceCode", "loadID", "messageId", "messageTime", "recordID", "subjectId"},
+		VerbosityFull:    {"dataSourceCode", "jsonData", "loadID", "messageId", "messageTime", "recordID", "subjectId"},
+	} {
+		g2engine := &G2engine{NotificationVerbosity: verbosity}
+		observer := &testObserver{
+			id:       "Observer 1",
+			messages: make(chan string, 10),
+		}
+		err := g2engine.RegisterObserver(ctx, observer)
+		testError(test, ctx, g2engine, err)
+		err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smith"}`, loadId)
+		testError(test, ctx, g2engine, err)
+		// The notification of RegisterObserver() may be delivered after that of AddRecord().
+		details := map[string]string{}
+		for details["messageId"] != "8001" {
+			details = map[string]string{}
+			err = json.Unmarshal([]byte(<-observer.messages), &details)
+			testError(test, ctx, g2engine, err)
+		}
+		actual := []string{}
+		for key := range details {
+			actual = append(actual, key)
+		}
+		assert.ElementsMatch(test, expected, actual, verbosity)
+	}
+}
+
+func TestG2engine_Cluster(test *testing.T) {
+	ctx := context.TODO()
+	cluster := &Cluster{}
+	upgraded := &G2engine{
+		Cluster:                   cluster,
+		Stateful:                  true,
+		GetEntityByEntityIDResult: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"LAST_SEEN_DT":"2023-02-16 21:43:10.000","RECORDS":[{"RECORD_ID":"1001","LAST_SEEN_DT":"2023-02-16 21:43:10.000"}]}}`,
+	}
+	legacy := &G2engine{
+		Cluster:                   cluster,
+		Stateful:                  true,
+		GetEntityByEntityIDResult: upgraded.GetEntityByEntityIDResult,
+		OmitResponseKeys:          []string{"LAST_SEEN_DT"},
+	}
+	err := upgraded.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smith"}`, loadId)
+	testError(test, ctx, upgraded, err)
+	assert.Equal(test, 1, legacy.RecordCount())
+	assert.Len(test, legacy.FindStoredRecords(map[string]string{"NAME_LAST": "Smith"}), 1)
+	actual, err := upgraded.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, upgraded, err)
+	assert.Contains(test, actual, "LAST_SEEN_DT")
+	actual, err = legacy.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, legacy, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"RECORDS":[{"RECORD_ID":"1001"}]}}`, actual)
+}
+
+func TestG2engine_OnStateTransition(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	states := []State{}
+	g2engine.OnStateTransition(func(state State) {
+		states = append(states, state)
+	})
+	err := g2engine.Init(ctx, "Test module name", "{}", 0)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.PrimeEngine(ctx)
+	testError(test, ctx, g2engine, err)
+	g2engine.SetDatabaseDown(true)
+	g2engine.SetDatabaseDown(true)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+	assert.ErrorContains(test, err, "database is down")
+	g2engine.SetDatabaseDown(false)
+	err = g2engine.PurgeRepository(ctx)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.Destroy(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, []State{StateInitialized, StatePrimed, StateDatabaseDown, StateDatabaseUp, StatePurged, StateDestroyed}, states)
+}
+
+func TestG2engine_Destroy_error(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		DestroyError: errors.New("cannot release resources"),
+	}
+	states := []State{}
+	g2engine.OnStateTransition(func(state State) {
+		states = append(states, state)
+	})
+	err := g2engine.Destroy(ctx)
+	assert.ErrorIs(test, err, g2engine.DestroyError)
+	assert.Empty(test, states)
+	g2engine.DestroyError = nil
+	err = g2engine.Destroy(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, []State{StateDestroyed}, states)
+}
+
+func TestG2engine_Drain(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency:    50 * time.Millisecond,
+		ObserverFaults: ObserverFaults{Block: 50 * time.Millisecond},
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	<-observer.messages
+	states := []State{}
+	g2engine.OnStateTransition(func(state State) {
+		states = append(states, state)
+	})
+	done := make(chan error, 1)
+	go func() {
+		done <- g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+	}()
+	assert.Eventually(test, func() bool {
+		return g2engine.InFlight()["AddRecord"] == 1
+	}, time.Second, time.Millisecond)
+	start := time.Now()
+	err = g2engine.Drain(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.GreaterOrEqual(test, time.Since(start), 50*time.Millisecond)
+	assert.NoError(test, <-done)
+	assert.Contains(test, <-observer.messages, `"messageId":"8001"`)
+	assert.Equal(test, []State{StateDrained}, states)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1002", `{}`, loadId)
+	assert.ErrorContains(test, err, "draining")
+}
+
+func TestG2engine_Drain_retry(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency: 100 * time.Millisecond,
+	}
+	states := []State{}
+	g2engine.OnStateTransition(func(state State) {
+		states = append(states, state)
+	})
+	done := make(chan error, 1)
+	go func() {
+		done <- g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+	}()
+	assert.Eventually(test, func() bool {
+		return g2engine.InFlight()["AddRecord"] == 1
+	}, time.Second, time.Millisecond)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err := g2engine.Drain(timeoutCtx)
+	assert.ErrorIs(test, err, context.DeadlineExceeded)
+	assert.Empty(test, states)
+	err = g2engine.Drain(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.NoError(test, <-done)
+	assert.Equal(test, []State{StateDrained}, states)
+	err = g2engine.Drain(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, []State{StateDrained}, states)
+}
+
+func TestG2engine_UnregisterObserver_error(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		UnregisterObserverError: errors.New("cannot unregister"),
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2engine.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.UnregisterObserver(ctx, observer)
+	assert.ErrorIs(test, err, g2engine.UnregisterObserverError)
+	assert.NotNil(test, g2engine.getObservers())
+	g2engine.UnregisterObserverError = nil
+	err = g2engine.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2engine, err)
+	assert.Nil(test, g2engine.getObservers())
+}
+
+func TestG2engine_Init_strictIniParams(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{StrictIniParams: true}
+	err := g2engine.Init(ctx, "Test module name", "{}", 0)
+	assert.ErrorContains(test, err, "Invalid iniParams")
+	iniParams := iniparams.New().SQLConnection("sqlite3://na:na@/tmp/sqlite/G2C.db").JSON()
+	err = g2engine.Init(ctx, "Test module name", iniParams, 0)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_LatencySummaries(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency: 5 * time.Millisecond,
+	}
+	for i := 0; i < 4; i++ {
+		_, err := g2engine.GetActiveConfigID(ctx)
+		testError(test, ctx, g2engine, err)
+	}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	summaries := g2engine.LatencySummaries()
+	summary := summaries["GetActiveConfigID"]
+	assert.Equal(test, 4, summary.Count)
+	assert.GreaterOrEqual(test, summary.P50, 5*time.Millisecond)
+	assert.GreaterOrEqual(test, summary.P95, summary.P50)
+	assert.GreaterOrEqual(test, summary.Max, summary.P95)
+	assert.Equal(test, 1, summaries["AddRecord"].Count)
+	report := g2engine.LatencyReport()
+	assert.Contains(test, report, "AddRecord: n=1 p50=")
+	assert.Less(test, strings.Index(report, "AddRecord"), strings.Index(report, "GetActiveConfigID"))
+}
+
+func TestG2engine_Recorder(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		Recorder: recorder.New(),
+	}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+	testError(test, ctx, g2engine, err)
+	calls := g2engine.Recorder.Calls()
+	assert.Len(test, calls, 2)
+	assert.Equal(test, "g2engine", calls[0].Component)
+	assert.Equal(test, []interface{}{"CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, loadId}, calls[0].Arguments)
+	assert.Equal(test, []interface{}{"CUSTOMERS", "1001", int64(0)}, calls[1].Arguments)
+	assert.Equal(test, 1, g2engine.Recorder.CallCount("AddRecord"))
+	g2engine.Recorder.Reset()
+	assert.Equal(test, 0, g2engine.Recorder.CallCount("AddRecord"))
+}
+
+func TestG2engine_Stats_threadState(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		MaxConcurrentCalls: 8,
+		ResourceProfile: ResourceProfile{
+			PhysicalCores:   4,
+			LogicalCores:    8,
+			TotalMemory:     "62.6GB",
+			AvailableMemory: "52.7GB",
+			CPUUser:         13.5,
+		},
+	}
+	g2engine.SetDataSourceProfile("CUSTOMERS", DataSourceProfile{Latency: 100 * time.Millisecond})
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < 3; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_ = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+		}()
+	}
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		_, _ = g2engine.GetEntityByRecordID(ctx, "CUSTOMERS", "1001")
+	}()
+	assert.Eventually(test, func() bool {
+		inFlight := g2engine.InFlight()
+		return inFlight["AddRecord"] == 3 && inFlight["GetEntityByRecordID"] == 1
+	}, time.Second, time.Millisecond)
+	actual, err := g2engine.Stats(ctx)
+	testError(test, ctx, g2engine, err)
+	waitGroup.Wait()
+	document := map[string]map[string]json.RawMessage{}
+	err = json.Unmarshal([]byte(actual), &document)
+	assert.NoError(test, err)
+	assert.JSONEq(test, `{"active":4,"idle":4,"sqlExecuting":0,"loader":3,"resolver":1,"scoring":0}`, string(document["workload"]["threadState"]))
+	assert.Contains(test, string(document["workload"]["systemResources"]), `{"workerThreads":8}`)
+	assert.Contains(test, string(document["workload"]["systemResources"]), `{"totalMemory":"62.6GB"}`)
+	assert.Contains(test, string(document["workload"]["systemResources"]), `{"cpuUser":13.5}`)
+}
+
+func TestG2engine_SetRecordResult(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetRecordResult:              `{"DATA_SOURCE":"TEST","RECORD_ID":"000"}`,
+		GetEntityByRecordID_V2Result: `{"RESOLVED_ENTITY":{"ENTITY_ID":0}}`,
+	}
+	g2engine.SetRecordResult("TEST", "111", `{"DATA_SOURCE":"TEST","RECORD_ID":"111"}`)
+	g2engine.SetRecordResult("TEST", "222", `{"DATA_SOURCE":"TEST","RECORD_ID":"222"}`)
+	g2engine.SetEntityByRecordIDResult("TEST", "111", `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`)
+	actual, err := g2engine.GetRecord(ctx, "TEST", "111")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"DATA_SOURCE":"TEST","RECORD_ID":"111"}`, actual)
+	actual, err = g2engine.GetRecord_V2(ctx, "TEST", "222", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"DATA_SOURCE":"TEST","RECORD_ID":"222"}`, actual)
+	actual, err = g2engine.GetRecord(ctx, "TEST", "333")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.GetRecordResult, actual)
+	actual, err = g2engine.GetEntityByRecordID_V2(ctx, "TEST", "111", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`, actual)
+	actual, err = g2engine.GetEntityByRecordID_V2(ctx, "TEST", "222", 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.GetEntityByRecordID_V2Result, actual)
+}
+
+func TestG2engine_StrictFlags(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{StrictFlags: true}
+	_, err := g2engine.GetEntityByEntityID_V2(ctx, 1, int64(g2api.G2_ENTITY_DEFAULT_FLAGS))
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetEntityByEntityID_V2(ctx, 1, int64(g2api.G2_ENTITY_DEFAULT_FLAGS)|1<<62)
+	assert.ErrorContains(test, err, "not allowed: 0x4000000000000000")
+	g2engine.SetAllowedFlags("GetEntityByEntityID_V2", int64(g2api.G2_ENTITY_DEFAULT_FLAGS))
+	_, err = g2engine.GetEntityByEntityID_V2(ctx, 1, int64(g2api.G2_EXPORT_INCLUDE_SINGLETONS))
+	assert.ErrorContains(test, err, "not allowed: G2_EXPORT_INCLUDE_SINGLETONS")
+	_, err = g2engine.WhyEntities_V2(ctx, 1, 2, int64(g2api.G2_EXPORT_INCLUDE_SINGLETONS))
+	testError(test, ctx, g2engine, err)
+	g2engine.StrictFlags = false
+	_, err = g2engine.GetEntityByEntityID_V2(ctx, 1, 1<<62)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_Stateful_records(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Smith"}`, "")
+	testError(test, ctx, g2engine, err)
+	actual, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.JSONEq(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","JSON_DATA":{"NAME_LAST":"Smith"}}`, actual)
+	err = g2engine.ReplaceRecord(ctx, "CUSTOMERS", "1001", `{"NAME_LAST":"Jones"}`, "")
+	testError(test, ctx, g2engine, err)
+	actual, err = g2engine.GetRecord_V2(ctx, "CUSTOMERS", "1001", 0)
+	testError(test, ctx, g2engine, err)
+	assert.JSONEq(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","JSON_DATA":{"NAME_LAST":"Jones"}}`, actual)
+	err = g2engine.DeleteRecord(ctx, "CUSTOMERS", "1001", "")
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	assert.Error(test, err)
+	err = g2engine.DeleteRecord(ctx, "CUSTOMERS", "1001", "")
+	assert.Error(test, err)
+	_, err = g2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1002", "", 0)
+	assert.Error(test, err)
+}
+
+func TestG2engine_IsolatedLogger(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{IsolatedLogger: true}
+	isolatedlogger.CheckLevels(test, g2engine)
+	assert.Equal(test, logger.LevelInfo, g2engine.LogLevel())
+	err := g2engine.SetLogLevel(ctx, logger.LevelDebug)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, logger.LevelDebug, g2engine.LogLevel())
+	err = g2engine.SetLogLevel(ctx, logger.LevelInfo)
+	testError(test, ctx, g2engine, err)
+}
+
+func TestG2engine_PanicOnConcurrentMutation(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency:               100 * time.Millisecond,
+		PanicOnConcurrentMutation: true,
+	}
+	g2engine.SetRecordResult("CUSTOMERS", "1001", `{"RECORD_ID":"1001"}`)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = g2engine.GetRecord(ctx, "CUSTOMERS", "1002")
+	}()
+	for len(g2engine.InFlight()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	message := ""
+	func() {
+		defer func() {
+			message, _ = recover().(string)
+		}()
+		g2engine.SetRecordResult("CUSTOMERS", "1002", `{"RECORD_ID":"1002"}`)
+	}()
+	assert.Contains(test, message, "SetRecordResult changed the mock configuration while calls were in flight")
+	assert.Contains(test, message, "GetRecord in flight:")
+	assert.Contains(test, message, "TestG2engine_PanicOnConcurrentMutation")
+	for methodName, mutate := range map[string]func(){
+		"SetDatabaseDown":        func() { g2engine.SetDatabaseDown(true) },
+		"RestrictEntity":         func() { g2engine.RestrictEntity(1, "analyst") },
+		"PushRedoRecord":         func() { g2engine.PushRedoRecord(`{"REASON":"1"}`) },
+		"EnqueueFetchNextResult": func() { g2engine.EnqueueFetchNextResult("") },
+		"RegisterFixtureSet":     func() { g2engine.RegisterFixtureSet("empty", FixtureSet{}) },
+	} {
+		assert.Panics(test, mutate, methodName)
+	}
+	<-done
+	assert.NotPanics(test, func() {
+		g2engine.SetRecordResult("CUSTOMERS", "1002", `{"RECORD_ID":"1002"}`)
+	})
+}
+
+func TestG2engine_SearchByAttributesFunc(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		SearchByAttributesResult: `{"RESOLVED_ENTITIES":[]}`,
+		SearchByAttributesFunc: func(ctx context.Context, jsonData string) (string, error) {
+			if strings.Contains(jsonData, "Error") {
+				return "", errors.New("search failed")
+			}
+			return `{"SEARCHED":` + jsonData + `}`, nil
+		},
+	}
+	actual, err := g2engine.SearchByAttributes(ctx, `{"NAME_LAST":"Smith"}`)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"SEARCHED":{"NAME_LAST":"Smith"}}`, actual)
+	_, err = g2engine.SearchByAttributes(ctx, `{"NAME_LAST":"Error"}`)
+	assert.ErrorContains(test, err, "search failed")
+	g2engine.SearchByAttributesFunc = nil
+	actual, err = g2engine.SearchByAttributes(ctx, `{"NAME_LAST":"Smith"}`)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RESOLVED_ENTITIES":[]}`, actual)
+}
+
+func TestG2engine_ProcessRedoRecordWithInfoFunc(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		ExportConfigAndConfigIDFunc: func(ctx context.Context) (string, int64, error) {
+			return `{"G2_CONFIG":{}}`, 42, nil
+		},
+		ProcessRedoRecordWithInfoFunc: func(ctx context.Context, flags int64) (string, string, error) {
+			return `{"REASON":"1"}`, `{"AFFECTED_ENTITIES":[]}`, nil
+		},
+	}
+	config, configID, err := g2engine.ExportConfigAndConfigID(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"G2_CONFIG":{}}`, config)
+	assert.Equal(test, int64(42), configID)
+	result, withInfo, err := g2engine.ProcessRedoRecordWithInfo(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"REASON":"1"}`, result)
+	assert.Equal(test, `{"AFFECTED_ENTITIES":[]}`, withInfo)
+}
+
+func TestG2engine_UseFixtureSet(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetRecordResult:        `{"RECORD_ID":"default"}`,
+		CountRedoRecordsResult: 1,
+	}
+	g2engine.RegisterFixtureSet("happy-path", FixtureSet{
+		"GetRecordResult":          `{"RECORD_ID":"happy"}`,
+		"SearchByAttributesResult": `{"RESOLVED_ENTITIES":[{"ENTITY_ID":1}]}`,
+	})
+	g2engine.RegisterFixtureSet("errors", FixtureSet{
+		"GetRecordResult": `{}`,
+	})
+	assert.Empty(test, g2engine.ActiveFixtureSet())
+	err := g2engine.UseFixtureSet("happy-path")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, "happy-path", g2engine.ActiveFixtureSet())
+	actual, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RECORD_ID":"happy"}`, actual)
+	err = g2engine.UseFixtureSet("errors")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{}`, g2engine.GetRecordResult)
+	assert.Empty(test, g2engine.SearchByAttributesResult)
+	assert.Equal(test, int64(1), g2engine.CountRedoRecordsResult)
+	err = g2engine.UseFixtureSet("merge-heavy")
+	assert.ErrorContains(test, err, "Unknown fixture set [merge-heavy]")
+	g2engine.RegisterFixtureSet("invalid", FixtureSet{"CountRedoRecordsResult": "2"})
+	err = g2engine.UseFixtureSet("invalid")
+	assert.ErrorContains(test, err, "not a ...Result field")
+	assert.Equal(test, "errors", g2engine.ActiveFixtureSet())
+}
+
+func TestReadFixtureSet(test *testing.T) {
+	directory := test.TempDir()
+	err := os.WriteFile(filepath.Join(directory, "GetRecordResult.json"), []byte(`{"RECORD_ID":"1001"}`+"\n"), 0644)
+	assert.NoError(test, err)
+	err = os.WriteFile(filepath.Join(directory, "README.md"), []byte("Fixtures"), 0644)
+	assert.NoError(test, err)
+	actual, err := ReadFixtureSet(directory)
+	assert.NoError(test, err)
+	assert.Equal(test, FixtureSet{"GetRecordResult": `{"RECORD_ID":"1001"}`}, actual)
+	_, err = ReadFixtureSet(filepath.Join(directory, "missing"))
+	assert.Error(test, err)
+}
+
+func TestG2engine_New(test *testing.T) {
+	ctx := context.TODO()
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	g2engine, err := New(
+		WithResults(map[string]interface{}{
+			"GetRecordResult":        `{"RECORD_ID":"1001"}`,
+			"CountRedoRecordsResult": 2,
+		}),
+		WithErrors(map[string]error{"AddRecord": errors.New("add failed")}),
+		WithObservers(observer),
+	)
+	assert.NoError(test, err)
+	actual, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RECORD_ID":"1001"}`, actual)
+	count, err := g2engine.CountRedoRecords(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, int64(2), count)
+	err = g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, loadId)
+	assert.ErrorContains(test, err, "add failed")
+	assert.Contains(test, <-observer.messages, `"subjectId":"6034"`)
+	_, err = New(WithResults(map[string]interface{}{"GetRecord": ""}))
+	assert.Error(test, err)
+}
+
+func TestG2engine_SetResults(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			err := g2engine.SetLogLevel(ctx, logger.LevelInfo)
+			testError(test, ctx, g2engine, err)
+			err = g2engine.SetResults(map[string]interface{}{"GetRecordResult": `{"RECORD_ID":"1001"}`})
+			testError(test, ctx, g2engine, err)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+		testError(test, ctx, g2engine, err)
+	}
+	<-done
+	actual, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RECORD_ID":"1001"}`, actual)
+	err = g2engine.SetResults(map[string]interface{}{"GetRecord": ""})
+	assert.Error(test, err)
+}
+
+func TestG2engine_ExportScenario(test *testing.T) {
+	ctx := context.TODO()
+	exporter := &G2engine{
+		GetRecordResult:        `{"RECORD_ID":"1001"}`,
+		CountRedoRecordsResult: 2,
+	}
+	exporter.SetRecordResult("CUSTOMERS", "1002", `{"RECORD_ID":"1002"}`)
+	exporter.SetDataSourceProfile("WATCHLIST", DataSourceProfile{Latency: 10 * time.Millisecond, ErrorRate: 1})
+	document, err := exporter.ExportScenario()
+	testError(test, ctx, exporter, err)
+	assert.JSONEq(test, `{
+		"RESULTS":{"GetRecordResult":"{\"RECORD_ID\":\"1001\"}","CountRedoRecordsResult":2},
+		"KEYED_RESULTS":{"GetRecord":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002","RESULT":"{\"RECORD_ID\":\"1002\"}"}]},
+		"CHAOS_PROFILE":{"WATCHLIST":{"LATENCY":"10ms","ERROR_RATE":1}}
+	}`, document)
+
+	importer := &G2engine{}
+	err = importer.ImportScenario(document)
+	testError(test, ctx, importer, err)
+	actual, err := importer.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, importer, err)
+	assert.Equal(test, `{"RECORD_ID":"1001"}`, actual)
+	actual, err = importer.GetRecord(ctx, "CUSTOMERS", "1002")
+	testError(test, ctx, importer, err)
+	assert.Equal(test, `{"RECORD_ID":"1002"}`, actual)
+	count, err := importer.CountRedoRecords(ctx)
+	testError(test, ctx, importer, err)
+	assert.Equal(test, int64(2), count)
+	_, err = importer.GetRecord(ctx, "WATCHLIST", "1003")
+	assert.Error(test, err)
+	reexported, err := importer.ExportScenario()
+	testError(test, ctx, importer, err)
+	assert.JSONEq(test, document, reexported)
+
+	err = importer.ImportScenario(`{"CHAOS_PROFILE":{"WATCHLIST":{"LATENCY":"soon"}}}`)
+	assert.Error(test, err)
+	err = importer.ImportScenario(`{"RESULTS":{"GetRecord":""}}`)
+	assert.Error(test, err)
+}
+
+func TestG2engine_RestrictDataSource(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GetRecordResult:  `{"RECORD_ID":"1001"}`,
+		WhyRecordsResult: `{"WHY_RESULTS":[]}`,
+	}
+	g2engine.RestrictDataSource("WATCHLIST", "analyst")
+	_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetRecord(ctx, "WATCHLIST", "1001")
+	assert.ErrorContains(test, err, "Permission denied")
+	_, err = g2engine.WhyRecords(ctx, "CUSTOMERS", "1001", "WATCHLIST", "1002")
+	assert.ErrorContains(test, err, "Permission denied")
+	g2engine.Role = "analyst"
+	actual, err := g2engine.GetRecord(ctx, "WATCHLIST", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"RECORD_ID":"1001"}`, actual)
+}
+
+func TestG2engine_RestrictEntity(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		Role: "auditor",
+	}
+	g2engine.RestrictEntity(5, "analyst", "supervisor")
+	_, err := g2engine.GetEntityByEntityID(ctx, 1)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetEntityByEntityID_V2(ctx, 5, 0)
+	assert.ErrorContains(test, err, "entity [5]")
+	_, err = g2engine.FindPathByEntityID(ctx, 1, 5, 2)
+	assert.ErrorContains(test, err, "Permission denied")
+	g2engine.RestrictEntity(5)
+	g2engine.Role = "analyst"
+	_, err = g2engine.WhyEntities(ctx, 5, 1)
+	assert.ErrorContains(test, err, "Permission denied")
+}
+
+func TestG2engine_RestrictEntity_stored(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{Stateful: true}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, loadId)
+	testError(test, ctx, g2engine, err)
+	g2engine.RestrictEntity(1, "analyst")
+	actual, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	assert.ErrorContains(test, err, "Permission denied")
+	assert.Empty(test, actual)
+	actual, err = g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+	assert.ErrorContains(test, err, "Permission denied")
+	assert.Empty(test, actual)
+	actual, err = g2engine.GetEntityByEntityID(ctx, 1)
+	assert.ErrorContains(test, err, "Permission denied")
+	assert.Empty(test, actual)
+	g2engine.RestrictDataSource("CUSTOMERS", "analyst")
+	actual, err = g2engine.GetRecord_V2(ctx, "CUSTOMERS", "1001", 0)
+	assert.ErrorContains(test, err, "data source [CUSTOMERS]")
+	assert.Empty(test, actual)
+	g2engine.Role = "analyst"
+	actual, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	assert.Contains(test, actual, "Robert Smith")
+}
+
+func TestG2engine_mockError(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		Stateful: true,
+	}
+	_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	var mockError *mockerror.Error
+	assert.True(test, errors.As(err, &mockError))
+	assert.Equal(test, 4916, mockError.Code)
+	assert.Equal(test, "GetRecord", mockError.Method)
+	assert.Equal(test, map[string]string{"dataSourceCode": "CUSTOMERS", "recordID": "1001"}, mockError.Identifiers)
+	assert.True(test, mockerror.IsBadInput(err))
+	assert.Equal(test, mockerror.ClassNotFound, mockError.Class)
+	g2engine.RestrictEntity(5)
+	_, err = g2engine.WhyEntities_V2(ctx, 1, 5, 0)
+	assert.Equal(test, 4922, mockerror.Code(err))
+	assert.True(test, errors.As(err, &mockError))
+	assert.Equal(test, "WhyEntities_V2", mockError.Method)
+	assert.Equal(test, "5", mockError.Identifiers["entityID"])
+}
+
+func TestG2engine_mockError_senzing(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.ScheduleFault("AddRecord", FaultSchedule{Call: 2, Failures: 1, Err: mockerror.NewSenzing(mockerror.DatabaseConnectionLost, "AddRecord", nil)})
+	loaded := 0
+	for _, recordID := range []string{"1001", "1002", "1003"} {
+		err := g2engine.AddRecord(ctx, "CUSTOMERS", recordID, `{}`, loadId)
+		if mockerror.IsRetryable(err) {
+			err = g2engine.AddRecord(ctx, "CUSTOMERS", recordID, `{}`, loadId)
+		}
+		testError(test, ctx, g2engine, err)
+		loaded++
+	}
+	assert.Equal(test, 3, loaded)
+}
+
+func TestG2engine_AutoFillResults(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		AutoFillResults:   true,
+		WhyEntitiesResult: `{"WHY_RESULTS":[{"ENTITY_ID":1}]}`,
+	}
+	actual, err := g2engine.FindPathByEntityID(ctx, 1, 2, 3)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, `{"ENTITY_PATHS":[],"ENTITIES":[]}`, actual)
+	actual, err = g2engine.WhyEntities(ctx, 1, 2)
+	testError(test, ctx, g2engine, err)
+	assert.Equal(test, g2engine.WhyEntitiesResult, actual)
+	actual, err = g2engine.GetRedoRecord(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Empty(test, actual)
+}
+
+func TestG2engine_SetLatency(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{}
+	g2engine.SetLatency("SearchByAttributes", 30*time.Millisecond)
+	g2engine.SetLatencyRange("WhyEntities", 10*time.Millisecond, 20*time.Millisecond)
+	_, err := g2engine.SearchByAttributes(ctx, `{}`)
+	testError(test, ctx, g2engine, err)
+	_, err = g2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, ctx, g2engine, err)
+	for i := 0; i < 5; i++ {
+		_, err = g2engine.WhyEntities(ctx, 1, 2)
+		testError(test, ctx, g2engine, err)
+	}
+	summaries := g2engine.LatencySummaries()
+	assert.GreaterOrEqual(test, summaries["SearchByAttributes"].Max, 30*time.Millisecond)
+	assert.Less(test, summaries["GetRecord"].Max, 10*time.Millisecond)
+	assert.Equal(test, 5, summaries["WhyEntities"].Count)
+	assert.GreaterOrEqual(test, summaries["WhyEntities"].P50, 10*time.Millisecond)
+}
+
+func TestG2engine_PrimeEngine_coldStart(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		CallLatency:     10 * time.Millisecond,
+		ColdStartCalls:  2,
+		ColdStartFactor: 5,
+	}
+	err := g2engine.Init(ctx, "Test module name", "{}", 0)
+	testError(test, ctx, g2engine, err)
+	durations := []time.Duration{}
+	for i := 0; i < 3; i++ {
+		entryTime := time.Now()
+		_, err = g2engine.GetActiveConfigID(ctx)
+		testError(test, ctx, g2engine, err)
+		durations = append(durations, time.Since(entryTime))
+	}
+	assert.GreaterOrEqual(test, durations[0], 50*time.Millisecond)
+	assert.GreaterOrEqual(test, durations[1], 30*time.Millisecond)
+	assert.Less(test, durations[2], 30*time.Millisecond)
+
+	err = g2engine.Init(ctx, "Test module name", "{}", 0)
+	testError(test, ctx, g2engine, err)
+	err = g2engine.PrimeEngine(ctx)
+	testError(test, ctx, g2engine, err)
+	entryTime := time.Now()
+	_, err = g2engine.GetActiveConfigID(ctx)
+	testError(test, ctx, g2engine, err)
+	assert.Less(test, time.Since(entryTime), 30*time.Millisecond)
+}
+
 func TestG2engine_Process(test *testing.T) {
 	ctx := context.TODO()
 	g2engine := getTestObject(ctx, test)
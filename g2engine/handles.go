@@ -0,0 +1,106 @@
+package g2engine
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The export handles issued by a G2engine.
+// Handles issued before SimulateRestart() are invalid until they are issued again.
+type handleRegistry struct {
+	mutex   sync.Mutex
+	open    map[uintptr]*handleState
+	invalid map[uintptr]bool
+}
+
+// The age and use of an open handle.
+type handleState struct {
+	issued time.Time
+	uses   int
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Record a handle returned to the caller.
+func (registry *handleRegistry) issue(handle uintptr) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if registry.open == nil {
+		registry.open = map[uintptr]*handleState{}
+	}
+	registry.open[handle] = &handleState{issued: time.Now()}
+	delete(registry.invalid, handle)
+}
+
+// Forget a closed handle.
+func (registry *handleRegistry) close(handle uintptr) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	delete(registry.open, handle)
+}
+
+// Count a use of a handle.
+func (registry *handleRegistry) use(handle uintptr) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if state, ok := registry.open[handle]; ok {
+		state.uses++
+	}
+}
+
+// Report whether a handle may be used.
+func (registry *handleRegistry) valid(handle uintptr) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	return !registry.invalid[handle]
+}
+
+// Describe why a handle has expired, if it is older than ttl or has been used maxUses times.
+// A ttl or maxUses of 0 never expires. Handles that were not issued do not expire.
+func (registry *handleRegistry) expired(handle uintptr, ttl time.Duration, maxUses int) (string, bool) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	state, ok := registry.open[handle]
+	if !ok {
+		return "", false
+	}
+	if age := time.Since(state.issued); ttl > 0 && age > ttl {
+		return fmt.Sprintf("issued %s ago, TTL is %s", age.Round(time.Millisecond), ttl), true
+	}
+	if maxUses > 0 && state.uses >= maxUses {
+		return fmt.Sprintf("used %d times, limit is %d", state.uses, maxUses), true
+	}
+	return "", false
+}
+
+// Make the open handles invalid.
+func (registry *handleRegistry) invalidate() {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if registry.invalid == nil {
+		registry.invalid = map[uintptr]bool{}
+	}
+	for handle := range registry.open {
+		registry.invalid[handle] = true
+	}
+	registry.open = nil
+}
+
+// Return an error if a handle was issued before SimulateRestart() or has expired.
+func (client *G2engine) checkHandle(handle uintptr) error {
+	if !client.handles.valid(handle) {
+		return client.newError("FetchNext", map[string]string{"responseHandle": strconv.FormatUint(uint64(handle), 10)}, 4910, handle)
+	}
+	if reason, expired := client.handles.expired(handle, client.HandleTTL, client.HandleMaxFetches); expired {
+		return client.newError("FetchNext", map[string]string{"responseHandle": strconv.FormatUint(uint64(handle), 10)}, 4911, handle, reason)
+	}
+	return nil
+}
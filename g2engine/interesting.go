@@ -0,0 +1,107 @@
+package g2engine
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+InterestingEntityRule makes the FindInterestingEntitiesBy...() methods report an interesting entity.
+A rule applies when both EntityID and DataSourceCode match the entity being queried.
+The data sources of an entity are those of its records in the Stateful repository.
+*/
+type InterestingEntityRule struct {
+	EntityID            int64    // Entity the rule applies to. 0 applies to any entity.
+	DataSourceCode      string   // Data source the rule applies to. "" applies to any data source.
+	InterestingEntityID int64    // Entity reported as interesting.
+	Degrees             int      // Degrees of separation reported. 0 is reported as 1.
+	Flags               []string // Flags reported for the interesting entity, e.g. "WATCHLIST".
+}
+
+// The interesting entity rules of a G2engine.
+type interestingRules struct {
+	mutex sync.RWMutex
+	rules []InterestingEntityRule
+}
+
+// Documents returned by the FindInterestingEntitiesBy...() methods.
+type interestingEntityJson struct {
+	EntityID      int64    `json:"ENTITY_ID"`
+	Degrees       int      `json:"DEGREES"`
+	Flags         []string `json:"FLAGS"`
+	SampleRecords []string `json:"SAMPLE_RECORDS"`
+}
+
+type interestingEntitiesJson struct {
+	InterestingEntities struct {
+		Entities []interestingEntityJson `json:"ENTITIES"`
+	} `json:"INTERESTING_ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add a rule.
+func (rules *interestingRules) add(rule InterestingEntityRule) {
+	rules.mutex.Lock()
+	defer rules.mutex.Unlock()
+	rules.rules = append(rules.rules, rule)
+}
+
+// Report whether any rules are set.
+func (rules *interestingRules) isEmpty() bool {
+	rules.mutex.RLock()
+	defer rules.mutex.RUnlock()
+	return len(rules.rules) == 0
+}
+
+// Return the interesting entities of an entity having the data sources, from the rules that apply.
+// Rules reporting the same interesting entity are combined.
+func (rules *interestingRules) entities(entityID int64, dataSourceCodes map[string]bool) []interestingEntityJson {
+	rules.mutex.RLock()
+	defer rules.mutex.RUnlock()
+	result := []interestingEntityJson{}
+	index := map[int64]int{}
+	for _, rule := range rules.rules {
+		if rule.EntityID != 0 && rule.EntityID != entityID {
+			continue
+		}
+		if len(rule.DataSourceCode) > 0 && !dataSourceCodes[rule.DataSourceCode] {
+			continue
+		}
+		degrees := rule.Degrees
+		if degrees == 0 {
+			degrees = 1
+		}
+		i, ok := index[rule.InterestingEntityID]
+		if !ok {
+			i = len(result)
+			index[rule.InterestingEntityID] = i
+			result = append(result, interestingEntityJson{
+				EntityID:      rule.InterestingEntityID,
+				Degrees:       degrees,
+				Flags:         []string{},
+				SampleRecords: []string{},
+			})
+		}
+		entry := &result[i]
+		if degrees < entry.Degrees {
+			entry.Degrees = degrees
+		}
+		entry.Flags = append(entry.Flags, rule.Flags...)
+	}
+	return result
+}
+
+// Render the document for an entity having the data sources, from the rules that apply.
+func (rules *interestingRules) document(entityID int64, dataSourceCodes map[string]bool) string {
+	result := interestingEntitiesJson{}
+	result.InterestingEntities.Entities = rules.entities(entityID, dataSourceCodes)
+	resultBytes, _ := json.Marshal(result)
+	return string(resultBytes)
+}
@@ -0,0 +1,41 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The results of record methods set for individual records, by method and record.
+// The method is the name of the method without "_V2", e.g. "GetRecord".
+type keyedResults struct {
+	mutex   sync.RWMutex
+	results map[string]map[recordKey]string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Set the result of a method for a record.
+func (keyed *keyedResults) set(methodName string, dataSourceCode string, recordID string, result string) {
+	keyed.mutex.Lock()
+	defer keyed.mutex.Unlock()
+	if keyed.results == nil {
+		keyed.results = map[string]map[recordKey]string{}
+	}
+	if keyed.results[methodName] == nil {
+		keyed.results[methodName] = map[recordKey]string{}
+	}
+	keyed.results[methodName][recordKey{dataSourceCode, recordID}] = result
+}
+
+// Return the result of a method for a record, reporting whether one is set.
+func (keyed *keyedResults) get(methodName string, dataSourceCode string, recordID string) (string, bool) {
+	keyed.mutex.RLock()
+	defer keyed.mutex.RUnlock()
+	result, ok := keyed.results[methodName][recordKey{dataSourceCode, recordID}]
+	return result, ok
+}
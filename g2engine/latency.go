@@ -0,0 +1,117 @@
+package g2engine
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// LatencySummary summarizes the durations of the calls of a method, including the latency simulated by the mock.
+type LatencySummary struct {
+	Count int           // Number of calls.
+	P50   time.Duration // Median duration.
+	P95   time.Duration // 95th percentile duration.
+	Max   time.Duration // Longest duration.
+}
+
+// The durations of the calls of a G2engine, by method name.
+type latencyRecorder struct {
+	mutex     sync.Mutex
+	durations map[string][]time.Duration
+}
+
+// The range of the latency simulated for a method.
+type latencyRange struct {
+	min time.Duration
+	max time.Duration
+}
+
+// The latencies set by SetLatency() and SetLatencyRange(), by method name.
+type methodLatencies struct {
+	mutex     sync.Mutex
+	latencies map[string]latencyRange
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Set the latency range of a method.
+func (latencies *methodLatencies) set(methodName string, latency latencyRange) {
+	latencies.mutex.Lock()
+	defer latencies.mutex.Unlock()
+	if latencies.latencies == nil {
+		latencies.latencies = map[string]latencyRange{}
+	}
+	latencies.latencies[methodName] = latency
+}
+
+// Return a latency drawn uniformly from the range of a method, reporting whether the method has a range.
+func (latencies *methodLatencies) draw(methodName string) (time.Duration, bool) {
+	latencies.mutex.Lock()
+	defer latencies.mutex.Unlock()
+	latency, ok := latencies.latencies[methodName]
+	if !ok {
+		return 0, false
+	}
+	if latency.max <= latency.min {
+		return latency.min, true
+	}
+	return latency.min + time.Duration(rand.Int63n(int64(latency.max-latency.min)+1)), true
+}
+
+// Record the duration of a call.
+func (recorder *latencyRecorder) add(methodName string, duration time.Duration) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	if recorder.durations == nil {
+		recorder.durations = map[string][]time.Duration{}
+	}
+	recorder.durations[methodName] = append(recorder.durations[methodName], duration)
+}
+
+// Summarize the durations of each method.
+func (recorder *latencyRecorder) summaries() map[string]LatencySummary {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	result := map[string]LatencySummary{}
+	for methodName, durations := range recorder.durations {
+		sorted := append([]time.Duration{}, durations...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		result[methodName] = LatencySummary{
+			Count: len(sorted),
+			P50:   percentile(sorted, 50),
+			P95:   percentile(sorted, 95),
+			Max:   sorted[len(sorted)-1],
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the nearest-rank percentile of sorted durations.
+func percentile(sorted []time.Duration, percent int) time.Duration {
+	rank := (percent*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// String describes a summary, e.g. "n=20 p50=5ms p95=12ms max=15ms".
+func (summary LatencySummary) String() string {
+	return fmt.Sprintf("n=%d p50=%s p95=%s max=%s", summary.Count, summary.P50, summary.P95, summary.Max)
+}
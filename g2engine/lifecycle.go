@@ -0,0 +1,91 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// State is a lifecycle state of a G2engine, reported to the callbacks added with OnStateTransition().
+type State string
+
+const (
+	StateInitialized  State = "initialized"   // Init() or InitWithConfigID() succeeded.
+	StatePrimed       State = "primed"        // PrimeEngine() succeeded.
+	StateDestroyed    State = "destroyed"     // Destroy() succeeded.
+	StatePurged       State = "purged"        // PurgeRepository() succeeded.
+	StateDatabaseDown State = "database-down" // SetDatabaseDown(true) was called.
+	StateDatabaseUp   State = "database-up"   // SetDatabaseDown(false) was called.
+	StateDrained      State = "drained"       // Drain() finished waiting for calls and notifications.
+)
+
+// The lifecycle callbacks and simulated database state of a G2engine.
+type lifecycle struct {
+	mutex        sync.Mutex
+	callbacks    []func(state State)
+	databaseDown bool
+	draining     bool
+	drained      bool
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add a callback.
+func (lifecycle *lifecycle) add(callback func(state State)) {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	lifecycle.callbacks = append(lifecycle.callbacks, callback)
+}
+
+// Call the callbacks, in the order they were added. The lock is not held, so callbacks may call the G2engine.
+func (lifecycle *lifecycle) transition(state State) {
+	lifecycle.mutex.Lock()
+	callbacks := append([]func(state State){}, lifecycle.callbacks...)
+	lifecycle.mutex.Unlock()
+	for _, callback := range callbacks {
+		callback(state)
+	}
+}
+
+// Set whether the database is down, reporting whether it changed.
+func (lifecycle *lifecycle) setDatabaseDown(down bool) bool {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	changed := lifecycle.databaseDown != down
+	lifecycle.databaseDown = down
+	return changed
+}
+
+// Report whether the database is down.
+func (lifecycle *lifecycle) isDatabaseDown() bool {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	return lifecycle.databaseDown
+}
+
+// Stop accepting calls.
+func (lifecycle *lifecycle) startDraining() {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	lifecycle.draining = true
+}
+
+// Record that draining finished, reporting whether it had not finished before.
+func (lifecycle *lifecycle) finishDraining() bool {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	wasDrained := lifecycle.drained
+	lifecycle.drained = true
+	return !wasDrained
+}
+
+// Report whether the G2engine has stopped accepting calls.
+func (lifecycle *lifecycle) isDraining() bool {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	return lifecycle.draining
+}
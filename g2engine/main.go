@@ -1,8 +1,93 @@
 package g2engine
 
+import (
+	"github.com/senzing/g2-sdk-go-mock/mockerror"
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2engineapi "github.com/senzing/g2-sdk-go/g2engine"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2engine package found messages having the format "senzing-6034xxxx".
 const ProductId = 6034
+
+// Environment variables read by Init() and InitWithConfigID().
+const (
+	EnvCallLatency  = "SENZING_MOCK_CALL_LATENCY"  // Default CallLatency, e.g. "25ms".
+	EnvChaosProfile = "SENZING_MOCK_CHAOS_PROFILE" // Default data source profiles, e.g. {"CUSTOMERS":{"LATENCY":"10ms","ERROR_RATE":0.1}}.
+	EnvFixtureDir   = "SENZING_MOCK_FIXTURE_DIR"   // Directory of default "...Result" fields, one file per field, e.g. "GetRecordResult.json".
+	EnvLogLevel     = "SENZING_MOCK_LOG_LEVEL"     // Log level, e.g. "DEBUG".
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Messages for errors simulated by the mock, in addition to those of g2engineapi.IdMessages.
+var mockIdMessages = map[int]string{
+	3901: "Notification dropped after %d attempts. Last error: %s. Message: %s",
+	4901: "Call to %s rejected. %d calls in flight reached the limit of %d.",
+	4902: "Unknown record: dsrc[%s], record[%s]. The record has not replicated yet.",
+	4903: "Call to %s rejected. Duplicate record: dsrc[%s], record[%s].",
+	4904: "Invalid value of environment variable %s: %s",
+	4905: "Simulated failure delivering a notification to observers.",
+	4906: "Delivering a notification to observers timed out after %s.",
+	4907: "Notification %d could not be encoded: %s",
+	4908: "Call to %s rejected. Configuration compatibility version mismatch: found [%s], expected [%s].",
+	4909: "Scenario [%s] diverged: %s",
+	4910: "Handle %d is not valid. It was issued before the engine was restarted.",
+	4911: "Handle %d has expired: %s.",
+	4912: "Call to %s failed. The database is down.",
+	4913: "Invalid iniParams: %s",
+	4914: "Unknown record: dsrc[%s], record[%s]. It is an alias of record[%s].",
+	4915: "Flags %d passed to %s include bits that are not allowed: %s.",
+	4916: "Unknown record: dsrc[%s], record[%s]",
+	4917: "Export handle %d is closed.",
+	4918: "Unknown fixture set [%s].",
+	4919: "Fixture set [%s] sets %s, which is not a ...Result field.",
+	4920: "Call to %s rejected. The engine is busy: %d calls are queued, the limit is %d. Retry later.",
+	4921: "Call to %s rejected. The engine is draining.",
+	4922: "Permission denied. The %s [%s] cannot be read with role [%s].",
+	4923: "Scheduled failure of call %d of %s.",
+}
+
+// Classes of the errors simulated by the mock, by message number. Others are mockerror.ClassUnknown.
+var mockErrorClasses = map[int]mockerror.Class{
+	4901: mockerror.ClassRetryable,
+	4902: mockerror.ClassNotFound,
+	4903: mockerror.ClassBadInput,
+	4910: mockerror.ClassBadInput,
+	4911: mockerror.ClassBadInput,
+	4912: mockerror.ClassRetryable,
+	4913: mockerror.ClassBadInput,
+	4914: mockerror.ClassNotFound,
+	4915: mockerror.ClassBadInput,
+	4916: mockerror.ClassNotFound,
+	4917: mockerror.ClassBadInput,
+	4920: mockerror.ClassRetryable,
+}
+
+// ----------------------------------------------------------------------------
+// Interface assertions
+// ----------------------------------------------------------------------------
+
+// G2engine can be used wherever the g2-sdk-go-base or g2-sdk-go-grpc implementation is used.
+var _ g2api.G2engine = &G2engine{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Combine g2engineapi.IdMessages and mockIdMessages.
+func idMessages() map[int]string {
+	result := map[int]string{}
+	for id, message := range g2engineapi.IdMessages {
+		result[id] = message
+	}
+	for id, message := range mockIdMessages {
+		result[id] = message
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+package g2engine
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The stack traces of the calls in flight, by goroutine, kept while PanicOnConcurrentMutation is set.
+type callStacks struct {
+	mutex  sync.Mutex
+	stacks map[uint64]callStack
+}
+
+// The method and stack trace of a call in flight.
+type callStack struct {
+	methodName string
+	stack      string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Keep the stack trace of a call starting in the current goroutine.
+func (calls *callStacks) add(methodName string) {
+	stack := currentStack()
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	if calls.stacks == nil {
+		calls.stacks = map[uint64]callStack{}
+	}
+	calls.stacks[goroutineID(stack)] = callStack{
+		methodName: methodName,
+		stack:      stack,
+	}
+}
+
+// Forget the stack trace of the call finishing in the current goroutine, if it was kept.
+func (calls *callStacks) remove() {
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	if len(calls.stacks) == 0 {
+		return
+	}
+	delete(calls.stacks, goroutineID(currentStack()))
+}
+
+// Describe the calls in flight with their stack traces, in the order of their goroutines.
+// Empty if there are none.
+func (calls *callStacks) describe() string {
+	calls.mutex.Lock()
+	defer calls.mutex.Unlock()
+	ids := make([]uint64, 0, len(calls.stacks))
+	for id := range calls.stacks {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	descriptions := make([]string, 0, len(ids))
+	for _, id := range ids {
+		descriptions = append(descriptions, fmt.Sprintf("%s in flight:\n%s", calls.stacks[id].methodName, calls.stacks[id].stack))
+	}
+	return strings.Join(descriptions, "\n")
+}
+
+// Panic if PanicOnConcurrentMutation is set and calls are in flight,
+// reporting the stack trace of the mock configuration method and of each call.
+func (client *G2engine) checkMutation(methodName string) {
+	if !client.PanicOnConcurrentMutation {
+		return
+	}
+	inFlight := client.callStacks.describe()
+	if len(inFlight) == 0 {
+		return
+	}
+	panic(fmt.Sprintf("g2engine: %s changed the mock configuration while calls were in flight.\n\n%s called:\n%s\n%s", methodName, methodName, currentStack(), inFlight))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the stack trace of the current goroutine.
+func currentStack() string {
+	buffer := make([]byte, 4096)
+	for {
+		length := runtime.Stack(buffer, false)
+		if length < len(buffer) {
+			return string(buffer[:length])
+		}
+		buffer = make([]byte, 2*len(buffer))
+	}
+}
+
+// Return the goroutine ID of a stack trace, which starts with "goroutine <id> [running]:".
+func goroutineID(stack string) uint64 {
+	fields := strings.SplitN(stack, " ", 3)
+	if len(fields) < 2 {
+		return 0
+	}
+	id, _ := strconv.ParseUint(fields[1], 10, 64)
+	return id
+}
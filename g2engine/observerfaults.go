@@ -0,0 +1,93 @@
+package g2engine
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ObserverFaults describes simulated failures delivering notifications to observers.
+type ObserverFaults struct {
+	ErrorRate float64       // Fraction of deliveries, from 0.0 to 1.0, that fail with an error.
+	Block     time.Duration // Delay before each delivery, as if an observer were slow to accept it.
+}
+
+// NotifyFailurePolicy describes how G2engine handles a notification that could not be delivered.
+type NotifyFailurePolicy int
+
+const (
+	// NotifyDrop discards the notification.
+	NotifyDrop NotifyFailurePolicy = iota
+
+	// NotifyRetry attempts delivery again, up to NotifyRetries times, before discarding the notification.
+	NotifyRetry
+
+	// NotifyLog logs a warning and discards the notification.
+	NotifyLog
+)
+
+// The notifications of a G2engine that could not be delivered.
+type droppedNotifications struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Record a notification that could not be delivered.
+func (dropped *droppedNotifications) add(message string) {
+	dropped.mutex.Lock()
+	defer dropped.mutex.Unlock()
+	dropped.messages = append(dropped.messages, message)
+}
+
+// Return a copy of the notifications that could not be delivered.
+func (dropped *droppedNotifications) list() []string {
+	dropped.mutex.Lock()
+	defer dropped.mutex.Unlock()
+	return append([]string{}, dropped.messages...)
+}
+
+// Deliver a message to the observers, applying ObserverFaults and NotifyFailurePolicy.
+func (client *G2engine) deliver(ctx context.Context, message string) {
+	attempts := 1
+	if client.NotifyFailurePolicy == NotifyRetry {
+		attempts += client.NotifyRetries
+	}
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if err = client.deliverOnce(ctx, message); err == nil {
+			return
+		}
+	}
+	client.dropped.add(message)
+	if client.NotifyFailurePolicy == NotifyLog {
+		client.log(3901, attempts, err.Error(), message)
+	}
+}
+
+// Attempt to deliver a message to the observers once.
+func (client *G2engine) deliverOnce(ctx context.Context, message string) error {
+	if client.ObserverFaults.Block > 0 {
+		if client.NotifyTimeout > 0 && client.ObserverFaults.Block > client.NotifyTimeout {
+			sleep(ctx, client.NotifyTimeout)
+			return client.logError(4906, client.NotifyTimeout)
+		}
+		sleep(ctx, client.ObserverFaults.Block)
+	}
+	if client.ObserverFaults.ErrorRate > 0 && rand.Float64() < client.ObserverFaults.ErrorRate {
+		return client.logError(4905)
+	}
+	observers := client.getObservers()
+	if observers == nil {
+		return nil
+	}
+	return observers.NotifyObservers(ctx, message)
+}
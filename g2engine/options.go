@@ -0,0 +1,111 @@
+package g2engine
+
+import (
+	"context"
+
+	"github.com/senzing/g2-sdk-go-mock/resulthelpers"
+	"github.com/senzing/go-logging/messagelogger"
+	"github.com/senzing/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Option configures a G2engine created by New().
+type Option func(client *G2engine) error
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function creates a G2engine configured by options, as an alternative to a struct literal, e.g.
+
+	g2engine, err := g2engine.New(
+		g2engine.WithResults(map[string]interface{}{"GetRecordResult": `{"RECORD_ID":"1001"}`}),
+		g2engine.WithObservers(observer),
+	)
+
+Input
+  - options: The options, applied in order.
+
+Output
+  - A G2engine.
+  - The error of the first option that failed.
+*/
+func New(options ...Option) (*G2engine, error) {
+	client := &G2engine{}
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The WithResults function sets "...Result" fields by name with resulthelpers.SetResults().
+
+Input
+  - results: The values of the fields, by field name, e.g. {"GetRecordResult": `{"RECORD_ID":"1001"}`}.
+*/
+func WithResults(results map[string]interface{}) Option {
+	return func(client *G2engine) error {
+		return resulthelpers.SetResults(client, results)
+	}
+}
+
+/*
+The WithErrors function sets Errors, the errors returned by interface methods by method name.
+
+Input
+  - errors: The errors, by method name, e.g. {"AddRecord": err}.
+*/
+func WithErrors(errors map[string]error) Option {
+	return func(client *G2engine) error {
+		if client.Errors == nil {
+			client.Errors = map[string]error{}
+		}
+		for methodName, err := range errors {
+			client.Errors[methodName] = err
+		}
+		return nil
+	}
+}
+
+/*
+The WithObservers function registers observers, as RegisterObserver() does.
+
+Input
+  - observers: The observers to notify.
+*/
+func WithObservers(observers ...observer.Observer) Option {
+	return func(client *G2engine) error {
+		for _, observer := range observers {
+			if err := client.RegisterObserver(context.Background(), observer); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+/*
+The WithLogger function sets the logger of the G2engine, instead of the one created on first use.
+Tracing follows the log level of the logger.
+
+Input
+  - logger: The logger, e.g. one created by isolatedlogger.New().
+*/
+func WithLogger(logger messagelogger.MessageLoggerInterface) Option {
+	return func(client *G2engine) error {
+		client.logger = logger
+		client.isTrace.Store(logger.GetLogLevel() == messagelogger.LevelTrace)
+		return nil
+	}
+}
@@ -0,0 +1,257 @@
+package g2engine
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// PathEdge describes a relationship between two entities in the graph searched by the FindPath...() methods.
+type PathEdge struct {
+	EntityID   int64   // One end of the relationship.
+	EntityID2  int64   // The other end of the relationship.
+	MatchLevel int     // MATCH_LEVEL of the relationship, e.g. 3 for POSSIBLY_RELATED.
+	Weight     float64 // Cost of traversing the relationship. Values less than or equal to 0 are treated as 1.
+
+	IsDisclosed bool // Reported as IS_DISCLOSED in RELATED_ENTITIES: the relationship was disclosed by a record.
+	IsAmbiguous bool // Reported as IS_AMBIGUOUS in RELATED_ENTITIES: the entity could belong to several others.
+}
+
+// The relationship graph of a G2engine, by entity ID.
+type pathGraph struct {
+	mutex sync.RWMutex
+	edges map[int64][]PathEdge
+}
+
+// A candidate path during the search.
+type pathCandidate struct {
+	entityIDs []int64
+	cost      float64
+}
+
+// Documents returned by the FindPath...() methods.
+type pathJson struct {
+	StartEntityID int64   `json:"START_ENTITY_ID"`
+	EndEntityID   int64   `json:"END_ENTITY_ID"`
+	Entities      []int64 `json:"ENTITIES"`
+}
+
+type pathDocJson struct {
+	EntityPaths []pathJson        `json:"ENTITY_PATHS"`
+	Entities    []json.RawMessage `json:"ENTITIES"`
+}
+
+type excludedEntitiesJson struct {
+	Entities []struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"ENTITIES"`
+}
+
+type excludedRecordsJson struct {
+	Records []struct {
+		DataSource string `json:"DATA_SOURCE"`
+		RecordID   string `json:"RECORD_ID"`
+	} `json:"RECORDS"`
+}
+
+type requiredDsrcsJson struct {
+	DataSources []string `json:"DATA_SOURCES"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add a relationship, replacing any relationship between the same entities.
+func (graph *pathGraph) add(edge PathEdge) {
+	graph.mutex.Lock()
+	defer graph.mutex.Unlock()
+	if graph.edges == nil {
+		graph.edges = map[int64][]PathEdge{}
+	}
+	reverse := edge
+	reverse.EntityID, reverse.EntityID2 = edge.EntityID2, edge.EntityID
+	for _, directed := range []PathEdge{edge, reverse} {
+		neighbors := graph.edges[directed.EntityID]
+		replaced := false
+		for i, neighbor := range neighbors {
+			if neighbor.EntityID2 == directed.EntityID2 {
+				neighbors[i] = directed
+				replaced = true
+			}
+		}
+		if !replaced {
+			graph.edges[directed.EntityID] = append(neighbors, directed)
+		}
+	}
+}
+
+// Return the relationships of an entity, in the order they were added.
+func (graph *pathGraph) related(entityID int64) []PathEdge {
+	graph.mutex.RLock()
+	defer graph.mutex.RUnlock()
+	return append([]PathEdge{}, graph.edges[entityID]...)
+}
+
+// Add the relationships of an entity to the RELATED_ENTITIES of its document,
+// with their IS_DISCLOSED and IS_AMBIGUOUS flags.
+func (graph *pathGraph) addRelated(doc *resultbuilder.EntityDoc, entityID int64) *resultbuilder.EntityDoc {
+	for _, edge := range graph.related(entityID) {
+		related := resultbuilder.RelatedEntity{
+			EntityID:   edge.EntityID2,
+			MatchLevel: edge.MatchLevel,
+		}
+		if edge.IsDisclosed {
+			related.IsDisclosed = 1
+		}
+		if edge.IsAmbiguous {
+			related.IsAmbiguous = 1
+		}
+		doc.AddRelated(related)
+	}
+	return doc
+}
+
+// Report whether the graph has any relationships.
+func (graph *pathGraph) isEmpty() bool {
+	graph.mutex.RLock()
+	defer graph.mutex.RUnlock()
+	return len(graph.edges) == 0
+}
+
+/*
+Find the lowest-cost path of at most maxDegree relationships.
+Ties are broken by the number of relationships, then by the order in which relationships were added.
+Relationships whose MATCH_LEVEL is in disallowed, and entities accepted by excluded, are not traversed.
+*/
+func (graph *pathGraph) find(entityID1 int64, entityID2 int64, maxDegree int, excluded func(int64) bool, disallowed map[int]bool) ([]int64, bool) {
+	graph.mutex.RLock()
+	defer graph.mutex.RUnlock()
+	if entityID1 == entityID2 {
+		return []int64{entityID1}, true
+	}
+	candidates := []pathCandidate{{entityIDs: []int64{entityID1}}}
+	settled := map[int64]int{}
+	for len(candidates) > 0 {
+		best := 0
+		for i, candidate := range candidates {
+			if candidate.cost < candidates[best].cost ||
+				(candidate.cost == candidates[best].cost && len(candidate.entityIDs) < len(candidates[best].entityIDs)) {
+				best = i
+			}
+		}
+		current := candidates[best]
+		candidates = append(candidates[:best], candidates[best+1:]...)
+		last := current.entityIDs[len(current.entityIDs)-1]
+		if last == entityID2 {
+			return current.entityIDs, true
+		}
+		degree := len(current.entityIDs) - 1
+		if settledDegree, ok := settled[last]; ok && settledDegree <= degree {
+			continue
+		}
+		settled[last] = degree
+		if degree >= maxDegree {
+			continue
+		}
+		for _, edge := range graph.edges[last] {
+			if disallowed[edge.MatchLevel] || containsEntityID(current.entityIDs, edge.EntityID2) {
+				continue
+			}
+			if edge.EntityID2 != entityID2 && excluded(edge.EntityID2) {
+				continue
+			}
+			weight := edge.Weight
+			if weight <= 0 {
+				weight = 1
+			}
+			candidates = append(candidates, pathCandidate{
+				entityIDs: append(append([]int64{}, current.entityIDs...), edge.EntityID2),
+				cost:      current.cost + weight,
+			})
+		}
+	}
+	return nil, false
+}
+
+// Return a FindPath...() document for the path found between two entities, or an empty path.
+// The RELATED_ENTITIES of each entity are its relationships in the graph.
+func (graph *pathGraph) document(entityID1 int64, entityID2 int64, path []int64) string {
+	result := pathDocJson{
+		EntityPaths: []pathJson{{
+			StartEntityID: entityID1,
+			EndEntityID:   entityID2,
+			Entities:      []int64{},
+		}},
+		Entities: []json.RawMessage{},
+	}
+	entityIDs := []int64{entityID1, entityID2}
+	if len(path) > 0 {
+		result.EntityPaths[0].Entities = path
+		entityIDs = path
+	}
+	seen := map[int64]bool{}
+	for _, entityID := range entityIDs {
+		if !seen[entityID] {
+			seen[entityID] = true
+			result.Entities = append(result.Entities, json.RawMessage(graph.addRelated(resultbuilder.NewEntityDoc().EntityID(entityID), entityID).JSON()))
+		}
+	}
+	resultBytes, _ := json.Marshal(result)
+	return string(resultBytes)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the entity IDs listed in an excludedEntities document. Invalid documents exclude nothing.
+func parseExcludedEntities(excludedEntities string) map[int64]bool {
+	result := map[int64]bool{}
+	parsed := excludedEntitiesJson{}
+	if json.Unmarshal([]byte(excludedEntities), &parsed) == nil {
+		for _, entity := range parsed.Entities {
+			result[entity.EntityID] = true
+		}
+	}
+	return result
+}
+
+// Return the data source codes listed in a requiredDsrcs document. Invalid documents require nothing.
+func parseRequiredDsrcs(requiredDsrcs string) map[string]bool {
+	result := map[string]bool{}
+	parsed := requiredDsrcsJson{}
+	if json.Unmarshal([]byte(requiredDsrcs), &parsed) == nil {
+		for _, dataSourceCode := range parsed.DataSources {
+			result[dataSourceCode] = true
+		}
+	}
+	return result
+}
+
+// Return the records listed in an excludedRecords document. Invalid documents exclude nothing.
+func parseExcludedRecords(excludedRecords string) []recordKey {
+	result := []recordKey{}
+	parsed := excludedRecordsJson{}
+	if json.Unmarshal([]byte(excludedRecords), &parsed) == nil {
+		for _, record := range parsed.Records {
+			result = append(result, recordKey{record.DataSource, record.RecordID})
+		}
+	}
+	return result
+}
+
+// Report whether a list of entity IDs contains an entity ID.
+func containsEntityID(entityIDs []int64, entityID int64) bool {
+	for _, candidate := range entityIDs {
+		if candidate == entityID {
+			return true
+		}
+	}
+	return false
+}
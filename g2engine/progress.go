@@ -0,0 +1,86 @@
+package g2engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Progress reports how far a simulated long-running operation has got, for consumers that poll.
+type Progress struct {
+	PercentComplete int       // From 0 to 100.
+	ETA             time.Time // Estimated completion time, by Clock. Zero if it cannot be estimated yet.
+	Done            bool      // The operation has completed.
+}
+
+// The progress of the last PurgeRepository() of a G2engine.
+type purgeProgress struct {
+	mutex    sync.Mutex
+	started  bool
+	start    time.Time
+	duration time.Duration
+	done     bool
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Record the start of a purge taking duration.
+func (purge *purgeProgress) begin(start time.Time, duration time.Duration) {
+	purge.mutex.Lock()
+	defer purge.mutex.Unlock()
+	purge.started = true
+	purge.start = start
+	purge.duration = duration
+	purge.done = false
+}
+
+// Record the end of a purge.
+func (purge *purgeProgress) end() {
+	purge.mutex.Lock()
+	defer purge.mutex.Unlock()
+	purge.done = true
+}
+
+// Return the progress of the purge at now, reporting whether a purge has started.
+func (purge *purgeProgress) at(now time.Time) (Progress, bool) {
+	purge.mutex.Lock()
+	defer purge.mutex.Unlock()
+	if !purge.started {
+		return Progress{}, false
+	}
+	eta := purge.start.Add(purge.duration)
+	if purge.done {
+		return Progress{PercentComplete: 100, ETA: eta, Done: true}, true
+	}
+	return Progress{PercentComplete: percentComplete(now.Sub(purge.start), purge.duration), ETA: eta}, true
+}
+
+// Return the current time of Clock, or resultbuilder.Now() if it is not set.
+func (client *G2engine) now() time.Time {
+	if client.Clock == nil {
+		return resultbuilder.Now()
+	}
+	return client.Clock()
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the percentage of total that done is, from 0 to 99 until done reaches total.
+func percentComplete(done time.Duration, total time.Duration) int {
+	if done >= total {
+		return 100
+	}
+	if done <= 0 {
+		return 0
+	}
+	return int(100 * done / total)
+}
@@ -0,0 +1,105 @@
+package g2engine
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The fields of a record that identify it.
+type recordIdentifierJson struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Convenience methods
+// ----------------------------------------------------------------------------
+
+/*
+The AddRecordFromReader method is AddRecord() with the record read from an io.Reader,
+e.g. a file or an HTTP request body.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - reader: The source of the JSON document containing the record.
+  - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
+*/
+func (client *G2engine) AddRecordFromReader(ctx context.Context, dataSourceCode string, recordID string, reader io.Reader, loadID string) error {
+	jsonData, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return client.AddRecord(ctx, dataSourceCode, recordID, string(jsonData), loadID)
+}
+
+/*
+The AddRecordFromRawMessage method is AddRecord() with the record as a json.RawMessage.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - jsonData: The JSON document containing the record.
+  - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
+*/
+func (client *G2engine) AddRecordFromRawMessage(ctx context.Context, dataSourceCode string, recordID string, jsonData json.RawMessage, loadID string) error {
+	return client.AddRecord(ctx, dataSourceCode, recordID, string(jsonData), loadID)
+}
+
+/*
+The AddRecordsFromReader method calls AddRecord() for each JSON document read from an io.Reader,
+e.g. a JSON Lines file. The data source code and record ID are the DATA_SOURCE and RECORD_ID of each document.
+It stops at the first error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - reader: The source of the JSON documents.
+  - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
+
+Output
+  - The number of records added.
+*/
+func (client *G2engine) AddRecordsFromReader(ctx context.Context, reader io.Reader, loadID string) (int, error) {
+	decoder := json.NewDecoder(reader)
+	result := 0
+	for {
+		jsonData := json.RawMessage{}
+		err := decoder.Decode(&jsonData)
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		identifier := recordIdentifierJson{}
+		if err := json.Unmarshal(jsonData, &identifier); err != nil {
+			return result, err
+		}
+		if err := client.AddRecord(ctx, identifier.DataSource, identifier.RecordID, string(jsonData), loadID); err != nil {
+			return result, err
+		}
+		result++
+	}
+}
+
+/*
+The ProcessFromReader method is Process() with the record read from an io.Reader.
+
+Input
+  - ctx: A context to control lifecycle.
+  - reader: The source of the JSON document containing the record.
+*/
+func (client *G2engine) ProcessFromReader(ctx context.Context, reader io.Reader) error {
+	record, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return client.Process(ctx, string(record))
+}
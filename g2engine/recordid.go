@@ -0,0 +1,22 @@
+package g2engine
+
+import (
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the record ID of a record added without one.
+// RecordIDGenerator takes precedence over the preset result, which takes precedence over resultbuilder.RecordID().
+// Adding the same JSON data to a data source again generates the same record ID, unless RecordIDGenerator is set.
+func (client *G2engine) returnedRecordID(dataSourceCode string, jsonData string, presetRecordID string) string {
+	if client.RecordIDGenerator != nil {
+		return client.RecordIDGenerator(dataSourceCode, jsonData)
+	}
+	if len(presetRecordID) > 0 {
+		return presetRecordID
+	}
+	return resultbuilder.RecordID(dataSourceCode, jsonData)
+}
@@ -0,0 +1,52 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The redo queue of a G2engine, filled by PushRedoRecord().
+// It is used once a record has been pushed, even after it is emptied.
+type redoQueue struct {
+	mutex   sync.Mutex
+	records []string
+	used    bool
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Append redo records to the queue.
+func (queue *redoQueue) push(records ...string) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.records = append(queue.records, records...)
+	queue.used = true
+}
+
+// Remove and return the first redo record, or "" if the queue is empty.
+// Returns false if the queue is not used.
+func (queue *redoQueue) pop() (string, bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	if !queue.used {
+		return "", false
+	}
+	if len(queue.records) == 0 {
+		return "", true
+	}
+	record := queue.records[0]
+	queue.records = queue.records[1:]
+	return record, true
+}
+
+// Return the number of redo records in the queue. Returns false if the queue is not used.
+func (queue *redoQueue) count() (int64, bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return int64(len(queue.records)), queue.used
+}
@@ -0,0 +1,48 @@
+package g2engine
+
+import (
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The results queued for successive calls of a method, by method name, e.g. "FetchNext".
+type resultQueues struct {
+	mutex   sync.Mutex
+	results map[string][]string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Append results to the queue of a method.
+func (queues *resultQueues) push(methodName string, results ...string) {
+	queues.mutex.Lock()
+	defer queues.mutex.Unlock()
+	if queues.results == nil {
+		queues.results = map[string][]string{}
+	}
+	queues.results[methodName] = append(queues.results[methodName], results...)
+}
+
+// Remove and return the first result of the queue of a method, reporting whether the queue had one.
+func (queues *resultQueues) pop(methodName string) (string, bool) {
+	queues.mutex.Lock()
+	defer queues.mutex.Unlock()
+	queue := queues.results[methodName]
+	if len(queue) == 0 {
+		return "", false
+	}
+	queues.results[methodName] = queue[1:]
+	return queue[0], true
+}
+
+// Return the number of results in the queue of a method.
+func (queues *resultQueues) count(methodName string) int {
+	queues.mutex.Lock()
+	defer queues.mutex.Unlock()
+	return len(queues.results[methodName])
+}
@@ -0,0 +1,106 @@
+package g2engine
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ScenarioStep is a call expected by a scenario started with RunScenario().
+type ScenarioStep struct {
+	Method      string // Name of the method expected, e.g. "AddRecord".
+	Description string // Reported by ScenarioProgress() and in divergence errors.
+}
+
+// ScenarioProgress describes how far the calls made have followed a scenario.
+type ScenarioProgress struct {
+	CurrentStep int            // Index of the next expected step. Equal to the number of steps when complete.
+	Satisfied   []ScenarioStep // Steps matched by calls, in order.
+	Remaining   []ScenarioStep // Steps not yet matched, in order.
+	Divergence  string         // The first call that did not match the next step. "" if none.
+}
+
+// The scenario of a G2engine.
+type scenario struct {
+	mutex      sync.Mutex
+	steps      []ScenarioStep
+	current    int
+	divergence string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Replace the steps and restart from the first one.
+func (scenario *scenario) start(steps []ScenarioStep) {
+	scenario.mutex.Lock()
+	defer scenario.mutex.Unlock()
+	scenario.steps = append([]ScenarioStep{}, steps...)
+	scenario.current = 0
+	scenario.divergence = ""
+}
+
+// Match a call against the next step. Returns a description of the divergence if it does not match.
+// Once a scenario has diverged, every call diverges.
+func (scenario *scenario) advance(methodName string) (string, bool) {
+	scenario.mutex.Lock()
+	defer scenario.mutex.Unlock()
+	if scenario.steps == nil {
+		return "", true
+	}
+	if len(scenario.divergence) > 0 {
+		return scenario.divergence, false
+	}
+	if scenario.current >= len(scenario.steps) {
+		scenario.divergence = fmt.Sprintf("unexpected call to %s after the last step %d", methodName, len(scenario.steps))
+		return scenario.divergence, false
+	}
+	step := scenario.steps[scenario.current]
+	if step.Method != methodName {
+		scenario.divergence = fmt.Sprintf("call to %s at step %d of %d, expected %s", methodName, scenario.current+1, len(scenario.steps), step)
+		return scenario.divergence, false
+	}
+	scenario.current++
+	return "", true
+}
+
+// Report the progress of the scenario.
+func (scenario *scenario) progress() ScenarioProgress {
+	scenario.mutex.Lock()
+	defer scenario.mutex.Unlock()
+	return ScenarioProgress{
+		CurrentStep: scenario.current,
+		Satisfied:   append([]ScenarioStep{}, scenario.steps[:scenario.current]...),
+		Remaining:   append([]ScenarioStep{}, scenario.steps[scenario.current:]...),
+		Divergence:  scenario.divergence,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// String describes a step, e.g. "AddRecord (load 1001)".
+func (step ScenarioStep) String() string {
+	if len(step.Description) == 0 {
+		return step.Method
+	}
+	return fmt.Sprintf("%s (%s)", step.Method, step.Description)
+}
+
+// String describes the position in the scenario, e.g. "step 3 of 10: AddRecord (load 1001)".
+func (progress ScenarioProgress) String() string {
+	total := len(progress.Satisfied) + len(progress.Remaining)
+	switch {
+	case len(progress.Divergence) > 0:
+		return "diverged: " + progress.Divergence
+	case len(progress.Remaining) == 0:
+		return fmt.Sprintf("complete: %d steps", total)
+	default:
+		return fmt.Sprintf("step %d of %d: %s", progress.CurrentStep+1, total, progress.Remaining[0])
+	}
+}
@@ -0,0 +1,107 @@
+package g2engine
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The document of ExportScenario() and ImportScenario().
+type scenarioDocumentJson struct {
+	Results      map[string]interface{}       `json:"RESULTS,omitempty"`
+	KeyedResults map[string][]keyedResultJson `json:"KEYED_RESULTS,omitempty"`
+	ChaosProfile map[string]chaosProfileJson  `json:"CHAOS_PROFILE,omitempty"`
+}
+
+// A result set for one record in a scenario document.
+type keyedResultJson struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+	Result     string `json:"RESULT"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the results set for individual records, by method, ordered by data source code and record ID.
+func (keyed *keyedResults) all() map[string][]keyedResultJson {
+	keyed.mutex.RLock()
+	defer keyed.mutex.RUnlock()
+	all := map[string][]keyedResultJson{}
+	for methodName, results := range keyed.results {
+		for key, result := range results {
+			all[methodName] = append(all[methodName], keyedResultJson{
+				DataSource: key.dataSourceCode,
+				RecordID:   key.recordID,
+				Result:     result,
+			})
+		}
+		sort.Slice(all[methodName], func(i, j int) bool {
+			if all[methodName][i].DataSource != all[methodName][j].DataSource {
+				return all[methodName][i].DataSource < all[methodName][j].DataSource
+			}
+			return all[methodName][i].RecordID < all[methodName][j].RecordID
+		})
+	}
+	return all
+}
+
+// Return the "...Result" fields that are set, by field name.
+func (client *G2engine) nonZeroResults() map[string]interface{} {
+	client.resultsMutex.RLock()
+	defer client.resultsMutex.RUnlock()
+	results := map[string]interface{}{}
+	value := reflect.ValueOf(client).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() || !strings.HasSuffix(field.Name, "Result") || value.Field(i).IsZero() {
+			continue
+		}
+		results[field.Name] = value.Field(i).Interface()
+	}
+	return results
+}
+
+// Return the data source profiles in the format of EnvChaosProfile.
+func (client *G2engine) chaosProfile() map[string]chaosProfileJson {
+	client.profilesMutex.RLock()
+	defer client.profilesMutex.RUnlock()
+	chaosProfile := map[string]chaosProfileJson{}
+	for dataSourceCode, profile := range client.dataSourceProfiles {
+		latency := ""
+		if profile.Latency > 0 {
+			latency = profile.Latency.String()
+		}
+		chaosProfile[dataSourceCode] = chaosProfileJson{
+			Latency:                   latency,
+			ErrorRate:                 profile.ErrorRate,
+			WithInfoTemplate:          profile.WithInfoTemplate,
+			InterestingEntitiesResult: profile.InterestingEntitiesResult,
+		}
+	}
+	return chaosProfile
+}
+
+// Convert a data source profile in the format of EnvChaosProfile.
+func (profile chaosProfileJson) dataSourceProfile() (DataSourceProfile, error) {
+	var latency time.Duration
+	if len(profile.Latency) > 0 {
+		var err error
+		latency, err = time.ParseDuration(profile.Latency)
+		if err != nil {
+			return DataSourceProfile{}, err
+		}
+	}
+	return DataSourceProfile{
+		Latency:                   latency,
+		ErrorRate:                 profile.ErrorRate,
+		WithInfoTemplate:          profile.WithInfoTemplate,
+		InterestingEntitiesResult: profile.InterestingEntitiesResult,
+	}, nil
+}
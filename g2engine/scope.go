@@ -0,0 +1,106 @@
+package g2engine
+
+import (
+	"reflect"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The configuration of a G2engine saved by WithScope().
+type scopeSnapshot struct {
+	fields             map[int]reflect.Value
+	dataSourceProfiles map[string]DataSourceProfile
+	policies           map[callPolicyKey]CallPolicy
+	calls              map[callPolicyKey]int
+	latencies          map[string]latencyRange
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Save the exported fields, data source profiles, call policies and method latencies.
+// Slices and maps are copied, so changing their elements in a scope does not change the saved configuration.
+func (client *G2engine) saveScope() *scopeSnapshot {
+	result := &scopeSnapshot{
+		fields: map[int]reflect.Value{},
+	}
+	client.resultsMutex.RLock()
+	value := reflect.ValueOf(client).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if !value.Type().Field(i).IsExported() {
+			continue
+		}
+		field := value.Field(i)
+		saved := reflect.New(field.Type()).Elem()
+		if field.Kind() == reflect.Slice && !field.IsNil() {
+			saved.Set(reflect.AppendSlice(reflect.MakeSlice(field.Type(), 0, field.Len()), field))
+		} else if field.Kind() == reflect.Map && !field.IsNil() {
+			saved.Set(reflect.MakeMapWithSize(field.Type(), field.Len()))
+			for iterator := field.MapRange(); iterator.Next(); {
+				saved.SetMapIndex(iterator.Key(), iterator.Value())
+			}
+		} else {
+			saved.Set(field)
+		}
+		result.fields[i] = saved
+	}
+	client.resultsMutex.RUnlock()
+
+	client.profilesMutex.RLock()
+	if client.dataSourceProfiles != nil {
+		result.dataSourceProfiles = map[string]DataSourceProfile{}
+		for dataSourceCode, profile := range client.dataSourceProfiles {
+			result.dataSourceProfiles[dataSourceCode] = profile
+		}
+	}
+	client.profilesMutex.RUnlock()
+
+	client.callPolicies.mutex.Lock()
+	if client.callPolicies.policies != nil {
+		result.policies = map[callPolicyKey]CallPolicy{}
+		result.calls = map[callPolicyKey]int{}
+		for key, policy := range client.callPolicies.policies {
+			result.policies[key] = policy
+		}
+		for key, count := range client.callPolicies.calls {
+			result.calls[key] = count
+		}
+	}
+	client.callPolicies.mutex.Unlock()
+
+	client.methodLatencies.mutex.Lock()
+	if client.methodLatencies.latencies != nil {
+		result.latencies = map[string]latencyRange{}
+		for methodName, latency := range client.methodLatencies.latencies {
+			result.latencies[methodName] = latency
+		}
+	}
+	client.methodLatencies.mutex.Unlock()
+	return result
+}
+
+// Restore the configuration saved by saveScope().
+func (client *G2engine) restoreScope(snapshot *scopeSnapshot) {
+	client.resultsMutex.Lock()
+	value := reflect.ValueOf(client).Elem()
+	for i, saved := range snapshot.fields {
+		value.Field(i).Set(saved)
+	}
+	client.resultsMutex.Unlock()
+
+	client.profilesMutex.Lock()
+	client.dataSourceProfiles = snapshot.dataSourceProfiles
+	client.profilesMutex.Unlock()
+
+	client.callPolicies.mutex.Lock()
+	client.callPolicies.policies = snapshot.policies
+	client.callPolicies.calls = snapshot.calls
+	client.callPolicies.mutex.Unlock()
+
+	client.methodLatencies.mutex.Lock()
+	client.methodLatencies.latencies = snapshot.latencies
+	client.methodLatencies.mutex.Unlock()
+}
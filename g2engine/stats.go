@@ -0,0 +1,116 @@
+package g2engine
+
+import (
+	"encoding/json"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ResourceProfile describes the host reported in the "systemResources" section of generated Stats() documents.
+type ResourceProfile struct {
+	PhysicalCores   int     // Reported as "physicalCores".
+	LogicalCores    int     // Reported as "logicalCores". Also the "workerThreads" if MaxConcurrentCalls is 0.
+	TotalMemory     string  // Reported as "totalMemory", e.g. "62.6GB".
+	AvailableMemory string  // Reported as "availableMemory", e.g. "52.7GB".
+	CPUUser         float64 // Reported as "cpuUser" of "systemLoad", in percent.
+	CPUSystem       float64 // Reported as "cpuSystem" of "systemLoad", in percent.
+}
+
+// The document returned by Stats() when StatsResult is not set.
+type statsJson struct {
+	Workload struct {
+		LoadedRecords   int                 `json:"loadedRecords"`
+		ThreadState     threadStateJson     `json:"threadState"`
+		SystemResources systemResourcesJson `json:"systemResources"`
+	} `json:"workload"`
+}
+
+type threadStateJson struct {
+	Active       int `json:"active"`
+	Idle         int `json:"idle"`
+	SqlExecuting int `json:"sqlExecuting"`
+	Loader       int `json:"loader"`
+	Resolver     int `json:"resolver"`
+	Scoring      int `json:"scoring"`
+}
+
+type systemResourcesJson struct {
+	InitResources []map[string]interface{} `json:"initResources"`
+	CurrResources []map[string]interface{} `json:"currResources"`
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Methods counted as "loader" threads. Other in-flight calls, except Stats(), are "resolver" threads.
+var loaderMethods = map[string]bool{
+	"AddRecord":                             true,
+	"AddRecordWithInfo":                     true,
+	"AddRecordWithInfoWithReturnedRecordID": true,
+	"AddRecordWithReturnedRecordID":         true,
+	"DeleteRecord":                          true,
+	"DeleteRecordWithInfo":                  true,
+	"Process":                               true,
+	"ProcessWithInfo":                       true,
+	"ProcessWithResponse":                   true,
+	"ProcessWithResponseResize":             true,
+	"ReplaceRecord":                         true,
+	"ReplaceRecordWithInfo":                 true,
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Render the Stats() document from the in-flight calls, the worker threads and the ResourceProfile.
+// The Stats() call generating the document is not counted.
+func (client *G2engine) statsDocument() string {
+	document := statsJson{}
+	document.Workload.LoadedRecords = client.records().recordCount()
+	threadState := threadStateJson{}
+	for methodName, count := range client.InFlight() {
+		if methodName == "Stats" {
+			count--
+		}
+		if count <= 0 {
+			continue
+		}
+		threadState.Active += count
+		if loaderMethods[methodName] {
+			threadState.Loader += count
+		} else {
+			threadState.Resolver += count
+		}
+	}
+	workerThreads := client.MaxConcurrentCalls
+	if workerThreads == 0 {
+		workerThreads = client.ResourceProfile.LogicalCores
+	}
+	if workerThreads > threadState.Active {
+		threadState.Idle = workerThreads - threadState.Active
+	}
+	document.Workload.ThreadState = threadState
+	profile := client.ResourceProfile
+	document.Workload.SystemResources = systemResourcesJson{
+		InitResources: []map[string]interface{}{
+			{"physicalCores": profile.PhysicalCores},
+			{"logicalCores": profile.LogicalCores},
+			{"totalMemory": profile.TotalMemory},
+			{"availableMemory": profile.AvailableMemory},
+		},
+		CurrResources: []map[string]interface{}{
+			{"availableMemory": profile.AvailableMemory},
+			{"activeThreads": threadState.Active},
+			{"workerThreads": workerThreads},
+			{"systemLoad": []map[string]float64{
+				{"cpuUser": profile.CPUUser},
+				{"cpuSystem": profile.CPUSystem},
+			}},
+		},
+	}
+	resultBytes, _ := json.Marshal(document)
+	return string(resultBytes)
+}
@@ -0,0 +1,285 @@
+package g2engine
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Provenance describes how a record came to be in the in-memory repository of a Stateful G2engine.
+type Provenance struct {
+	LoadID       string    // The loadID of the last call that added or replaced the record.
+	InsertTime   time.Time // When the record was first added, by the Clock of the G2engine.
+	ReplaceCount int       // The number of times the record was replaced after it was added.
+}
+
+// StoredRecord is a record in the in-memory repository of a Stateful G2engine, as returned by FindStoredRecords().
+type StoredRecord struct {
+	Provenance
+	DataSourceCode string
+	RecordID       string
+	JsonData       string
+	EntityID       int64
+}
+
+// A record held by the in-memory store of a stateful G2engine.
+type storedRecord struct {
+	dataSourceCode string
+	recordID       string
+	jsonData       string
+	loadID         string
+	entityID       int64
+	insertTime     time.Time
+	updateTime     time.Time
+	replaceCount   int
+}
+
+// The "_PROVENANCE" object added to generated GetRecord_V2() documents.
+type provenanceJson struct {
+	LoadID       string `json:"LOAD_ID"`
+	InsertTime   string `json:"INSERT_TIME"`
+	ReplaceCount int    `json:"REPLACE_COUNT"`
+}
+
+// The document returned by GetRecord_V2() for a record of the in-memory repository.
+type storedRecordJson struct {
+	DataSource string          `json:"DATA_SOURCE"`
+	RecordID   string          `json:"RECORD_ID"`
+	JsonData   json.RawMessage `json:"JSON_DATA"`
+	Provenance *provenanceJson `json:"_PROVENANCE,omitempty"`
+}
+
+// The in-memory repository of a stateful G2engine.
+// Each record resolves to an entity of its own, unless merged.
+type recordStore struct {
+	mutex        sync.RWMutex
+	records      map[recordKey]*storedRecord
+	lastEntityID int64
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add or replace a record at a time. A replaced record keeps its entity.
+func (store *recordStore) put(dataSourceCode string, recordID string, jsonData string, loadID string, now time.Time) storedRecord {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.records == nil {
+		store.records = map[recordKey]*storedRecord{}
+	}
+	key := recordKey{dataSourceCode, recordID}
+	record, ok := store.records[key]
+	if !ok {
+		store.lastEntityID++
+		record = &storedRecord{
+			dataSourceCode: dataSourceCode,
+			recordID:       recordID,
+			entityID:       store.lastEntityID,
+			insertTime:     now,
+		}
+		store.records[key] = record
+	} else {
+		record.replaceCount++
+	}
+	record.jsonData = jsonData
+	record.loadID = loadID
+	record.updateTime = now
+	return *record
+}
+
+// Remove a record, reporting whether it was present.
+func (store *recordStore) delete(dataSourceCode string, recordID string) (storedRecord, bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	key := recordKey{dataSourceCode, recordID}
+	record, ok := store.records[key]
+	if !ok {
+		return storedRecord{}, false
+	}
+	delete(store.records, key)
+	return *record, true
+}
+
+// Return a record, reporting whether it is present.
+func (store *recordStore) get(dataSourceCode string, recordID string) (storedRecord, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	record, ok := store.records[recordKey{dataSourceCode, recordID}]
+	if !ok {
+		return storedRecord{}, false
+	}
+	return *record, true
+}
+
+// Return the data source codes of the records of an entity.
+func (store *recordStore) dataSources(entityID int64) map[string]bool {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	result := map[string]bool{}
+	for _, record := range store.records {
+		if record.entityID == entityID {
+			result[record.dataSourceCode] = true
+		}
+	}
+	return result
+}
+
+// Return the records of an entity, sorted by data source code and record ID.
+func (store *recordStore) entityRecords(entityID int64) []storedRecord {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	result := []storedRecord{}
+	for _, record := range store.records {
+		if record.entityID == entityID {
+			result = append(result, *record)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].dataSourceCode != result[j].dataSourceCode {
+			return result[i].dataSourceCode < result[j].dataSourceCode
+		}
+		return result[i].recordID < result[j].recordID
+	})
+	return result
+}
+
+// Move the records of the entity of the second record into the entity of the first,
+// reporting whether both records are present.
+func (store *recordStore) merge(key1 recordKey, key2 recordKey) bool {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	record1, ok1 := store.records[key1]
+	record2, ok2 := store.records[key2]
+	if !ok1 || !ok2 {
+		return false
+	}
+	mergedEntityID := record2.entityID
+	for _, record := range store.records {
+		if record.entityID == mergedEntityID {
+			record.entityID = record1.entityID
+		}
+	}
+	return true
+}
+
+// Remove all records.
+func (store *recordStore) purge() {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.records = nil
+}
+
+// Return the number of entities having at least one record.
+func (store *recordStore) entityCount() int {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	entityIDs := map[int64]bool{}
+	for _, record := range store.records {
+		entityIDs[record.entityID] = true
+	}
+	return len(entityIDs)
+}
+
+// Return the number of records.
+func (store *recordStore) recordCount() int {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	return len(store.records)
+}
+
+// Return the number of records of each data source.
+func (store *recordStore) recordCountByDataSource() map[string]int {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	result := map[string]int{}
+	for _, record := range store.records {
+		result[record.dataSourceCode]++
+	}
+	return result
+}
+
+// Return the records having all the attribute values of the filter, sorted by data source code and record ID.
+// An attribute matches a top-level attribute of a record, or an attribute of an object in one of its lists.
+// The DATA_SOURCE and RECORD_ID attributes also match the identifiers the record was stored under.
+func (store *recordStore) find(filter map[string]string) []StoredRecord {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	result := []StoredRecord{}
+	for _, record := range store.records {
+		if record.matches(filter) {
+			result = append(result, record.export())
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DataSourceCode != result[j].DataSourceCode {
+			return result[i].DataSourceCode < result[j].DataSourceCode
+		}
+		return result[i].RecordID < result[j].RecordID
+	})
+	return result
+}
+
+// Report whether a record has all the attribute values of a filter.
+func (record *storedRecord) matches(filter map[string]string) bool {
+	groups := attributeGroups(record.jsonData)
+	for key, value := range filter {
+		if (key == "DATA_SOURCE" && value == record.dataSourceCode) || (key == "RECORD_ID" && value == record.recordID) {
+			continue
+		}
+		found := false
+		for _, group := range groups {
+			if attribute, ok := group[key]; ok && attribute == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the exported form of a record.
+func (record *storedRecord) export() StoredRecord {
+	return StoredRecord{
+		Provenance: Provenance{
+			LoadID:       record.loadID,
+			InsertTime:   record.insertTime,
+			ReplaceCount: record.replaceCount,
+		},
+		DataSourceCode: record.dataSourceCode,
+		RecordID:       record.recordID,
+		JsonData:       record.jsonData,
+		EntityID:       record.entityID,
+	}
+}
+
+// Render the document returned by GetRecord_V2(), with a "_PROVENANCE" object if requested.
+func (record *storedRecord) document(withProvenance bool) string {
+	result := storedRecordJson{
+		DataSource: record.dataSourceCode,
+		RecordID:   record.recordID,
+		JsonData:   json.RawMessage(record.jsonData),
+	}
+	if !json.Valid(result.JsonData) {
+		result.JsonData = json.RawMessage("{}")
+	}
+	if withProvenance {
+		result.Provenance = &provenanceJson{
+			LoadID:       record.loadID,
+			InsertTime:   resultbuilder.FormatTimestamp(record.insertTime),
+			ReplaceCount: record.replaceCount,
+		}
+	}
+	resultBytes, _ := json.Marshal(result)
+	return string(resultBytes)
+}
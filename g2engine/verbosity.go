@@ -0,0 +1,60 @@
+package g2engine
+
+import (
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// NotificationVerbosity describes how much detail observer notifications carry.
+type NotificationVerbosity int
+
+const (
+	// VerbositySummary sends the identifiers and the parameters of each call, e.g. dataSourceCode, recordID and loadID.
+	VerbositySummary NotificationVerbosity = iota
+
+	// VerbosityIDs sends only identifiers, e.g. messageId, dataSourceCode and recordID, for light notifications in load tests.
+	VerbosityIDs
+
+	// VerbosityFull adds the payloads of each call to VerbositySummary:
+	// the JSON document of records as "jsonData" and the response as "result".
+	VerbosityFull
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add a payload to the details of a notification, if NotificationVerbosity is VerbosityFull.
+func (client *G2engine) addPayload(details map[string]string, key string, value string) {
+	if client.NotificationVerbosity == VerbosityFull {
+		details[key] = value
+	}
+}
+
+// Remove the details that NotificationVerbosity does not include.
+func (client *G2engine) applyVerbosity(details map[string]string) {
+	if client.NotificationVerbosity != VerbosityIDs {
+		return
+	}
+	for key := range details {
+		if !isIdentifierDetail(key) {
+			delete(details, key)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Report whether a detail of a notification is an identifier.
+func isIdentifierDetail(key string) bool {
+	switch key {
+	case "dataSourceCode", "messageTime", "error":
+		return true
+	}
+	return strings.HasSuffix(key, "ID") || strings.HasSuffix(key, "Id")
+}
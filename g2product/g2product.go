@@ -8,10 +8,17 @@ package g2product
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-mock/internal/observers"
+	"github.com/senzing/g2-sdk-go-mock/isolatedlogger"
+	"github.com/senzing/g2-sdk-go-mock/mockerror"
+	"github.com/senzing/g2-sdk-go-mock/notification"
+	"github.com/senzing/g2-sdk-go-mock/recorder"
 	g2productapi "github.com/senzing/g2-sdk-go/g2product"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
@@ -24,13 +31,33 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2product struct {
-	isTrace                           bool
+	isTrace                           atomic.Bool
 	logger                            messagelogger.MessageLoggerInterface
-	observers                         subject.Subject
+	loggerMutex                       sync.Mutex
+	observers                         observers.Registry
 	LicenseResult                     string
 	ValidateLicenseFileResult         string
 	ValidateLicenseStringBase64Result string
 	VersionResult                     string
+
+	NotificationEncoder notification.Encoder // Encoding of observer notifications. nil is notification.JSON.
+	Recorder            *recorder.Recorder   // If set, captures each call of an interface method.
+	Clock               func() time.Time     // If set, the ValidateLicense...() methods fail once its time is after the "expireDate" of LicenseResult.
+
+	DestroyError            error // Returned by Destroy(), e.g. to test cleanup after a failed shutdown.
+	UnregisterObserverError error // Returned by UnregisterObserver(), which then keeps the observer registered.
+
+	IsolatedLogger bool // Use a logger whose log level is not changed by messagelogger.SetLogLevel(). See the isolatedlogger package.
+
+	recordsConsumed int64
+}
+
+// LicenseUsage describes the simulated consumption of the license's record limit.
+type LicenseUsage struct {
+	RecordLimit      int64   // The "recordLimit" of LicenseResult; 0 if it is not specified.
+	RecordsConsumed  int64   // Records counted by ConsumeRecords().
+	RecordsRemaining int64   // RecordLimit less RecordsConsumed, never below 0.
+	PercentConsumed  float64 // RecordsConsumed as a percentage of RecordLimit.
 }
 
 // ----------------------------------------------------------------------------
@@ -39,14 +66,35 @@ type G2product struct {
 
 // Get the Logger singleton.
 func (client *G2product) getLogger() messagelogger.MessageLoggerInterface {
+	client.loggerMutex.Lock()
+	defer client.loggerMutex.Unlock()
 	if client.logger == nil {
-		client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, g2productapi.IdMessages, g2productapi.IdStatuses, messagelogger.LevelInfo)
+		if client.IsolatedLogger {
+			client.logger, _ = isolatedlogger.New(ProductId, idMessages(), g2productapi.IdStatuses, messagelogger.LevelInfo)
+		} else {
+			client.logger, _ = messagelogger.NewSenzingApiLogger(ProductId, idMessages(), g2productapi.IdStatuses, messagelogger.LevelInfo)
+		}
 	}
 	return client.logger
 }
 
+// Create the error of a message, as a *mockerror.Error describing the method and identifiers of the call.
+func (client *G2product) newError(methodName string, identifiers map[string]string, messageNumber int, details ...interface{}) error {
+	return mockerror.New(client.getLogger().Error(messageNumber, details...), messageNumber, methodName, identifiers)
+}
+
+// Get the registered observers. Returns nil if there are none.
+func (client *G2product) getObservers() subject.Subject {
+	return client.observers.Subject()
+}
+
 // Notify registered observers.
+// Failures are logged, as notifications are usually sent from goroutines that cannot return them.
 func (client *G2product) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	observers := client.getObservers()
+	if observers == nil {
+		return
+	}
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
@@ -54,14 +102,66 @@ func (client *G2product) notify(ctx context.Context, messageId int, err error, d
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	encode := client.NotificationEncoder
+	if encode == nil {
+		encode = notification.JSON
+	}
+	message, err := encode(details)
+	if err == nil {
+		err = observers.NotifyObservers(ctx, message)
+	}
 	if err != nil {
-		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
+		client.getLogger().Log(4901, messageId, err.Error())
 	}
 }
 
+// Build the Version() document from the ldflags values, overridden by environment variables.
+func defaultVersionResult() string {
+	values := map[string]string{}
+	for environmentVariable, value := range versionEnvironmentVariables {
+		values[environmentVariable] = *value
+		if environmentValue, ok := os.LookupEnv(environmentVariable); ok {
+			values[environmentVariable] = environmentValue
+		}
+	}
+	result := map[string]interface{}{
+		"PRODUCT_NAME":  values["SENZING_MOCK_PRODUCT_NAME"],
+		"VERSION":       values["SENZING_MOCK_VERSION"],
+		"BUILD_VERSION": values["SENZING_MOCK_BUILD_VERSION"],
+		"BUILD_DATE":    values["SENZING_MOCK_BUILD_DATE"],
+		"BUILD_NUMBER":  values["SENZING_MOCK_BUILD_NUMBER"],
+		"COMPATIBILITY_VERSION": map[string]string{
+			"CONFIG_VERSION": "10",
+		},
+		"SCHEMA_VERSION": map[string]string{
+			"ENGINE_SCHEMA_VERSION":           "3.5",
+			"MINIMUM_REQUIRED_SCHEMA_VERSION": "3.0",
+			"MAXIMUM_REQUIRED_SCHEMA_VERSION": "3.99",
+		},
+	}
+	resultBytes, _ := json.Marshal(result)
+	return string(resultBytes)
+}
+
+// Return an error if Clock is set and its time is after the "expireDate" of LicenseResult.
+func (client *G2product) checkLicenseExpiry(methodName string) error {
+	if client.Clock == nil {
+		return nil
+	}
+	license := struct {
+		ExpireDate string `json:"expireDate"`
+	}{}
+	_ = json.Unmarshal([]byte(client.LicenseResult), &license)
+	expireDate, err := time.Parse("2006-01-02", license.ExpireDate)
+	if err != nil {
+		return nil
+	}
+	if client.Clock().After(expireDate.AddDate(0, 0, 1)) {
+		return client.newError(methodName, nil, 4902, license.ExpireDate)
+	}
+	return nil
+}
+
 // Trace method entry.
 func (client *G2product) traceEntry(errorNumber int, details ...interface{}) {
 	client.getLogger().Log(errorNumber, details...)
@@ -72,6 +172,60 @@ func (client *G2product) traceExit(errorNumber int, details ...interface{}) {
 	client.getLogger().Log(errorNumber, details...)
 }
 
+// ----------------------------------------------------------------------------
+// Mock configuration methods
+// ----------------------------------------------------------------------------
+
+/*
+The ConsumeRecords method counts records against the license's record limit,
+as if they had been loaded into the Senzing repository.
+
+Input
+  - recordCount: The number of records consumed. A negative number releases records.
+*/
+func (client *G2product) ConsumeRecords(recordCount int64) {
+	atomic.AddInt64(&client.recordsConsumed, recordCount)
+}
+
+/*
+The LicenseUsage method reports the records consumed against the record limit of LicenseResult.
+
+Output
+  - The current license usage.
+*/
+func (client *G2product) LicenseUsage() LicenseUsage {
+	license := struct {
+		RecordLimit int64 `json:"recordLimit"`
+	}{}
+	_ = json.Unmarshal([]byte(client.LicenseResult), &license)
+	result := LicenseUsage{
+		RecordLimit:     license.RecordLimit,
+		RecordsConsumed: atomic.LoadInt64(&client.recordsConsumed),
+	}
+	if result.RecordLimit > result.RecordsConsumed {
+		result.RecordsRemaining = result.RecordLimit - result.RecordsConsumed
+	}
+	if result.RecordLimit > 0 {
+		result.PercentConsumed = 100 * float64(result.RecordsConsumed) / float64(result.RecordLimit)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Mock inspection methods
+// ----------------------------------------------------------------------------
+
+/*
+The LogLevel method returns the log level of the logger of the G2product,
+so that tests can check it with isolatedlogger.CheckLevels().
+
+Output
+  - The log level, e.g. logger.LevelInfo.
+*/
+func (client *G2product) LogLevel() logger.Level {
+	return logger.Level(client.getLogger().GetLogLevel())
+}
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
@@ -84,18 +238,20 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2product) Destroy(ctx context.Context) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(3)
 	}
+	client.Recorder.Record("g2product", "Destroy")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.DestroyError
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8001, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(4, err, time.Since(entryTime))
 	}
 	return err
@@ -110,18 +266,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2product) GetSdkId(ctx context.Context) string {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(25)
 	}
+	client.Recorder.Record("g2product", "GetSdkId")
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8007, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(26, err, time.Since(entryTime))
 	}
 	return "mock"
@@ -138,12 +295,13 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2product) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(9, moduleName, iniParams, verboseLogging)
 	}
+	client.Recorder.Record("g2product", "Init", moduleName, iniParams, verboseLogging)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"iniParams":      iniParams,
@@ -153,7 +311,7 @@ func (client *G2product) Init(ctx context.Context, moduleName string, iniParams
 			client.notify(ctx, 8002, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(10, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
@@ -168,23 +326,37 @@ Input
 Output
   - A JSON document containing Senzing license metadata.
     See the example output.
+
+Once records have been counted by ConsumeRecords(), "recordsConsumed" is set in the JSON object of LicenseResult.
+A LicenseResult that is not a JSON object is returned unchanged.
 */
 func (client *G2product) License(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(11)
 	}
+	client.Recorder.Record("g2product", "License")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.LicenseResult
+	recordsConsumed := atomic.LoadInt64(&client.recordsConsumed)
+	if recordsConsumed != 0 {
+		license := map[string]interface{}{}
+		if json.Unmarshal([]byte(result), &license) == nil {
+			license["recordsConsumed"] = recordsConsumed
+			resultBytes, _ := json.Marshal(license)
+			result = string(resultBytes)
+		}
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8003, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(12, client.LicenseResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(12, result, err, time.Since(entryTime))
 	}
-	return client.LicenseResult, err
+	return result, err
 }
 
 /*
@@ -195,15 +367,13 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2product) RegisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(21, observer.GetObserverId(ctx))
 	}
+	client.Recorder.Record("g2product", "RegisterObserver", observer.GetObserverId(ctx))
 	entryTime := time.Now()
-	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
-	}
-	err := client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
+	err := client.observers.Register(ctx, observer)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"observerID": observer.GetObserverId(ctx),
@@ -211,7 +381,7 @@ func (client *G2product) RegisterObserver(ctx context.Context, observer observer
 			client.notify(ctx, 8008, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(22, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -225,14 +395,15 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2product) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(13, logLevel)
 	}
+	client.Recorder.Record("g2product", "SetLogLevel", logLevel)
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
-	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
-	if client.observers != nil {
+	client.isTrace.Store(client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{
 				"logLevel": logger.LevelToTextMap[logLevel],
@@ -240,7 +411,7 @@ func (client *G2product) SetLogLevel(ctx context.Context, logLevel logger.Level)
 			client.notify(ctx, 8009, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(14, logLevel, err, time.Since(entryTime))
 	}
 	return err
@@ -254,26 +425,27 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2product) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(23, observer.GetObserverId(ctx))
 	}
+	client.Recorder.Record("g2product", "UnregisterObserver", observer.GetObserverId(ctx))
 	entryTime := time.Now()
 	var err error = nil
-	if client.observers != nil {
+	err = client.UnregisterObserverError
+	if client.getObservers() != nil {
 		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
+		// client.notify is called synchronously before the observer is unregistered.
 		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// Then the observer may be unregistered, but observer goroutines will be OK.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8010, err, details)
 	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+	if err == nil {
+		err = client.observers.Unregister(ctx, observer)
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(24, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
@@ -281,6 +453,7 @@ func (client *G2product) UnregisterObserver(ctx context.Context, observer observ
 
 /*
 The ValidateLicenseFile method validates the licence file has not expired.
+In the mock, the license is LicenseResult, and its expiry is only evaluated if Clock is set.
 
 Input
   - ctx: A context to control lifecycle.
@@ -292,18 +465,20 @@ Output
   - The returned string has additional information.
 */
 func (client *G2product) ValidateLicenseFile(ctx context.Context, licenseFilePath string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(15, licenseFilePath)
 	}
+	client.Recorder.Record("g2product", "ValidateLicenseFile", licenseFilePath)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkLicenseExpiry("ValidateLicenseFile")
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8004, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(16, licenseFilePath, client.ValidateLicenseFileResult, err, time.Since(entryTime))
 	}
 	return client.ValidateLicenseFileResult, err
@@ -311,6 +486,7 @@ func (client *G2product) ValidateLicenseFile(ctx context.Context, licenseFilePat
 
 /*
 The ValidateLicenseStringBase64 method validates the licence, represented by a Base-64 string, has not expired.
+In the mock, the license is LicenseResult, and its expiry is only evaluated if Clock is set.
 
 Input
   - ctx: A context to control lifecycle.
@@ -323,18 +499,20 @@ Output
     See the example output.
 */
 func (client *G2product) ValidateLicenseStringBase64(ctx context.Context, licenseString string) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(17, licenseString)
 	}
+	client.Recorder.Record("g2product", "ValidateLicenseStringBase64", licenseString)
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	err = client.checkLicenseExpiry("ValidateLicenseStringBase64")
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8005, err, details)
 		}()
 	}
-	if client.isTrace {
+	if client.isTrace.Load() {
 		defer client.traceExit(18, licenseString, client.ValidateLicenseStringBase64Result, err, time.Since(entryTime))
 	}
 	return client.ValidateLicenseStringBase64Result, err
@@ -349,21 +527,30 @@ Input
 Output
   - A JSON document containing metadata about the Senzing Engine version being used.
     See the example output.
+
+If VersionResult is empty, the document is built from values set at build time via "-ldflags"
+or at runtime via the SENZING_MOCK_PRODUCT_NAME, SENZING_MOCK_VERSION, SENZING_MOCK_BUILD_VERSION,
+SENZING_MOCK_BUILD_DATE and SENZING_MOCK_BUILD_NUMBER environment variables.
 */
 func (client *G2product) Version(ctx context.Context) (string, error) {
-	if client.isTrace {
+	if client.isTrace.Load() {
 		client.traceEntry(19)
 	}
+	client.Recorder.Record("g2product", "Version")
 	var err error = nil
 	entryTime := time.Now()
-	if client.observers != nil {
+	result := client.VersionResult
+	if len(result) == 0 {
+		result = defaultVersionResult()
+	}
+	if client.getObservers() != nil {
 		go func() {
 			details := map[string]string{}
 			client.notify(ctx, 8006, err, details)
 		}()
 	}
-	if client.isTrace {
-		defer client.traceExit(20, client.VersionResult, err, time.Since(entryTime))
+	if client.isTrace.Load() {
+		defer client.traceExit(20, result, err, time.Since(entryTime))
 	}
-	return client.VersionResult, err
+	return result, err
 }
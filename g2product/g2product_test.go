@@ -2,9 +2,11 @@ package g2product
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
 	"github.com/senzing/g2-sdk-go/g2api"
@@ -21,6 +23,23 @@ var (
 	g2productSingleton *G2product
 )
 
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type testObserver struct {
+	id       string
+	messages chan string
+}
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return observer.id
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	observer.messages <- message
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -118,6 +137,39 @@ func TestG2product_License(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2product_LicenseUsage(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &G2product{
+		LicenseResult: `{"customer":"Senzing Public Test License","recordLimit":50000}`,
+	}
+	actual, err := g2product.License(ctx)
+	testError(test, ctx, g2product, err)
+	assert.Equal(test, g2product.LicenseResult, actual)
+	g2product.ConsumeRecords(12000)
+	g2product.ConsumeRecords(500)
+	usage := g2product.LicenseUsage()
+	assert.Equal(test, int64(50000), usage.RecordLimit)
+	assert.Equal(test, int64(12500), usage.RecordsConsumed)
+	assert.Equal(test, int64(37500), usage.RecordsRemaining)
+	assert.Equal(test, 25.0, usage.PercentConsumed)
+	actual, err = g2product.License(ctx)
+	testError(test, ctx, g2product, err)
+	assert.Equal(test, `{"customer":"Senzing Public Test License","recordLimit":50000,"recordsConsumed":12500}`, actual)
+}
+
+func TestG2product_License_recordsConsumed(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &G2product{LicenseResult: "{} \n"}
+	g2product.ConsumeRecords(10)
+	actual, err := g2product.License(ctx)
+	testError(test, ctx, g2product, err)
+	assert.JSONEq(test, `{"recordsConsumed":10}`, actual)
+	g2product.LicenseResult = `{"customer":"Senzing","recordsConsumed":5}`
+	actual, err = g2product.License(ctx)
+	testError(test, ctx, g2product, err)
+	assert.JSONEq(test, `{"customer":"Senzing","recordsConsumed":10}`, actual)
+}
+
 func TestG2product_ValidateLicenseFile(test *testing.T) {
 	ctx := context.TODO()
 	g2product := getTestObject(ctx, test)
@@ -127,6 +179,40 @@ func TestG2product_ValidateLicenseFile(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2product_UnregisterObserver_empty(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &G2product{}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 10),
+	}
+	err := g2product.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2product, err)
+	err = g2product.RegisterObserver(ctx, observer)
+	testError(test, ctx, g2product, err)
+	err = g2product.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2product, err)
+	err = g2product.UnregisterObserver(ctx, observer)
+	testError(test, ctx, g2product, err)
+}
+
+func TestG2product_ValidateLicenseFile_expired(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &G2product{
+		LicenseResult: `{"expireDate":"2023-11-29"}`,
+		Clock: func() time.Time {
+			return time.Date(2023, 11, 29, 23, 0, 0, 0, time.UTC)
+		},
+	}
+	_, err := g2product.ValidateLicenseFile(ctx, "g2.lic")
+	testError(test, ctx, g2product, err)
+	g2product.Clock = func() time.Time {
+		return time.Date(2023, 11, 30, 1, 0, 0, 0, time.UTC)
+	}
+	_, err = g2product.ValidateLicenseFile(ctx, "g2.lic")
+	assert.ErrorContains(test, err, "2023-11-29")
+}
+
 func TestG2product_ValidateLicenseStringBase64(test *testing.T) {
 	ctx := context.TODO()
 	g2product := getTestObject(ctx, test)
@@ -144,6 +230,22 @@ func TestG2product_Version(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2product_Version_default(test *testing.T) {
+	ctx := context.TODO()
+	g2product := &G2product{}
+	test.Setenv("SENZING_MOCK_VERSION", "3.6.0")
+	test.Setenv("SENZING_MOCK_BUILD_VERSION", "3.6.0.23100")
+	actual, err := g2product.Version(ctx)
+	testError(test, ctx, g2product, err)
+	version := map[string]interface{}{}
+	err = json.Unmarshal([]byte(actual), &version)
+	testError(test, ctx, g2product, err)
+	assert.Equal(test, "Senzing API", version["PRODUCT_NAME"])
+	assert.Equal(test, "3.6.0", version["VERSION"])
+	assert.Equal(test, "3.6.0.23100", version["BUILD_VERSION"])
+	assert.Equal(test, "2023-02-09", version["BUILD_DATE"])
+}
+
 func TestG2product_Destroy(test *testing.T) {
 	ctx := context.TODO()
 	g2product := getTestObject(ctx, test)
@@ -1,8 +1,73 @@
 package g2product
 
+import (
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2productapi "github.com/senzing/g2-sdk-go/g2product"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2product package found messages having the format "senzing-6036xxxx".
 const ProductId = 6036
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Values reported by Version() when VersionResult is not set.
+// They can be updated via "go build -ldflags" parameters, for example:
+//
+//	-X 'github.com/senzing/g2-sdk-go-mock/g2product.buildVersion=3.5.0.23041'
+//
+// or at runtime via the environment variables listed in versionEnvironmentVariables.
+var (
+	productName  string = "Senzing API"
+	version      string = "3.5.0"
+	buildVersion string = "3.5.0.23041"
+	buildDate    string = "2023-02-09"
+	buildNumber  string = "2023_02_09__23_01"
+)
+
+// Environment variables that override the ldflags values above.
+var versionEnvironmentVariables = map[string]*string{
+	"SENZING_MOCK_PRODUCT_NAME":  &productName,
+	"SENZING_MOCK_VERSION":       &version,
+	"SENZING_MOCK_BUILD_VERSION": &buildVersion,
+	"SENZING_MOCK_BUILD_DATE":    &buildDate,
+	"SENZING_MOCK_BUILD_NUMBER":  &buildNumber,
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Messages for errors reported by the mock, in addition to those of g2productapi.IdMessages.
+var mockIdMessages = map[int]string{
+	4901: "Notification %d could not be delivered to observers: %s",
+	4902: "License expired on %s.",
+}
+
+// ----------------------------------------------------------------------------
+// Interface assertions
+// ----------------------------------------------------------------------------
+
+// G2product can be used wherever the g2-sdk-go-base or g2-sdk-go-grpc implementation is used.
+var _ g2api.G2product = &G2product{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Combine g2productapi.IdMessages and mockIdMessages.
+func idMessages() map[int]string {
+	result := map[int]string{}
+	for id, message := range g2productapi.IdMessages {
+		result[id] = message
+	}
+	for id, message := range mockIdMessages {
+		result[id] = message
+	}
+	return result
+}
@@ -0,0 +1,6 @@
+/*
+The golden package compares responses from the mock objects with golden files.
+Golden files are stored in the canonical form of resulthelpers.Canonicalize(),
+so they do not change when the order of fields in a generated document changes.
+*/
+package golden
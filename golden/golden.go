@@ -0,0 +1,68 @@
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-mock/resulthelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// When set, AssertResponse() writes golden files instead of comparing with them, for example:
+//
+//	go test ./... -args -update-golden
+var update = flag.Bool("update-golden", false, "write golden files instead of comparing with them")
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the canonical form of a response, or the response itself if it is not JSON.
+func canonical(response string) string {
+	result, err := resulthelpers.Canonicalize(response)
+	if err != nil {
+		return response
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The AssertResponse function compares a response with a golden file.
+Both are compared in canonical form, so key order and formatting do not matter.
+When the -update-golden flag is set, the golden file is written from the response instead,
+creating its directory if needed.
+
+Input
+  - test: The test, benchmark or fuzz target.
+  - got: The response returned by a mock object.
+  - path: The golden file, e.g. "testdata/entity_1.json".
+
+Output
+  - True if the response matches the golden file, or the golden file was written.
+*/
+func AssertResponse(test testing.TB, got string, path string) bool {
+	test.Helper()
+	actual := canonical(got)
+	if *update {
+		err := os.MkdirAll(filepath.Dir(path), 0o755)
+		if err == nil {
+			err = os.WriteFile(path, []byte(actual+"\n"), 0o644)
+		}
+		return assert.NoError(test, err, "writing golden file %s", path)
+	}
+	expected, err := os.ReadFile(path)
+	if !assert.NoError(test, err, "reading golden file %s; run with -update-golden to create it", path) {
+		return false
+	}
+	return assert.Equal(test, canonical(string(expected)), actual, "response differs from golden file %s", path)
+}
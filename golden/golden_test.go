@@ -0,0 +1,45 @@
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-mock/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestAssertResponse(test *testing.T) {
+	AssertResponse(test, fixtures.Entity(fixtures.JohnsonEntityID).JSON(), "testdata/entity_1.json")
+}
+
+func TestAssertResponse_keyOrder(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "record.json")
+	err := os.WriteFile(path, []byte(`{"RECORD_ID":"1001","DATA_SOURCE":"CUSTOMERS"}`), 0o644)
+	assert.NoError(test, err)
+	AssertResponse(test, `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}`, path)
+}
+
+func TestAssertResponse_mismatch(test *testing.T) {
+	if *update {
+		test.Skip("golden files are written, not compared, with -update-golden")
+	}
+	path := filepath.Join(test.TempDir(), "record.json")
+	err := os.WriteFile(path, []byte(`{"RECORD_ID":"1001"}`), 0o644)
+	assert.NoError(test, err)
+	assert.False(test, AssertResponse(&testing.T{}, `{"RECORD_ID":"1002"}`, path))
+}
+
+func TestAssertResponse_update(test *testing.T) {
+	*update = true
+	defer func() { *update = false }()
+	path := filepath.Join(test.TempDir(), "new", "record.json")
+	AssertResponse(test, `{"RECORD_ID":"1001","DATA_SOURCE":"CUSTOMERS"}`, path)
+	actual, err := os.ReadFile(path)
+	assert.NoError(test, err)
+	assert.Equal(test, "{\n  \"DATA_SOURCE\": \"CUSTOMERS\",\n  \"RECORD_ID\": \"1001\"\n}\n", string(actual))
+}
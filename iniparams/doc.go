@@ -0,0 +1,10 @@
+/*
+The iniparams package builds and validates the engine configuration JSON, the "iniParams",
+passed to the Init() methods of the Senzing SDK, e.g.
+
+	{"PIPELINE":{"CONFIGPATH":"/etc/opt/senzing","RESOURCEPATH":"/opt/senzing/g2/resources","SUPPORTPATH":"/opt/senzing/data"},"SQL":{"CONNECTION":"sqlite3://na:na@/tmp/sqlite/G2C.db"}}
+
+A G2engine with StrictIniParams set validates its iniParams with Validate(),
+so code that constructs the configuration is tested against realistic parsing.
+*/
+package iniparams
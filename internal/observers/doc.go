@@ -0,0 +1,5 @@
+/*
+The observers package manages the observers registered with a mock object,
+so that every mock object registers and unregisters them the same way.
+*/
+package observers
@@ -0,0 +1,72 @@
+package observers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/senzing/go-observing/observer"
+	"github.com/senzing/go-observing/subject"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Registry holds the observers of a mock object. The zero value has no observers.
+type Registry struct {
+	mutex   sync.RWMutex
+	subject subject.Subject
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The Register method adds an observer. Registering an observer again has no effect.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to notify.
+*/
+func (registry *Registry) Register(ctx context.Context, observer observer.Observer) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if registry.subject == nil {
+		registry.subject = &subject.SubjectImpl{}
+	}
+	return registry.subject.RegisterObserver(ctx, observer)
+}
+
+/*
+The Unregister method removes an observer.
+Unregistering an observer that is not registered, including when none ever was, has no effect.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to stop notifying.
+*/
+func (registry *Registry) Unregister(ctx context.Context, observer observer.Observer) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if registry.subject == nil {
+		return nil
+	}
+	err := registry.subject.UnregisterObserver(ctx, observer)
+	if !registry.subject.HasObservers(ctx) {
+		registry.subject = nil
+	}
+	return err
+}
+
+/*
+The Subject method returns the registered observers.
+
+Output
+  - The subject notifying the observers, or nil if there are none.
+*/
+func (registry *Registry) Subject() subject.Subject {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	return registry.subject
+}
@@ -0,0 +1,40 @@
+package observers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing/go-observing/observer"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRegistry_Unregister_empty(test *testing.T) {
+	ctx := context.TODO()
+	registry := &Registry{}
+	observer1 := &observer.ObserverNull{Id: "Observer 1"}
+	assert.NoError(test, registry.Unregister(ctx, observer1))
+	assert.Nil(test, registry.Subject())
+}
+
+func TestRegistry_Register(test *testing.T) {
+	ctx := context.TODO()
+	registry := &Registry{}
+	observer1 := &observer.ObserverNull{Id: "Observer 1"}
+	observer2 := &observer.ObserverNull{Id: "Observer 2"}
+	assert.NoError(test, registry.Register(ctx, observer1))
+	assert.NoError(test, registry.Register(ctx, observer1))
+	assert.NoError(test, registry.Register(ctx, observer2))
+	assert.Len(test, registry.Subject().GetObservers(ctx), 2)
+	assert.NoError(test, registry.Unregister(ctx, observer1))
+	assert.NoError(test, registry.Unregister(ctx, observer1))
+	assert.Len(test, registry.Subject().GetObservers(ctx), 1)
+	assert.NoError(test, registry.Unregister(ctx, observer2))
+	assert.Nil(test, registry.Subject())
+	assert.NoError(test, registry.Unregister(ctx, observer2))
+	assert.NoError(test, registry.Register(ctx, observer2))
+	assert.Len(test, registry.Subject().GetObservers(ctx), 1)
+}
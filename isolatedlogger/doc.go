@@ -0,0 +1,8 @@
+/*
+The isolatedlogger package keeps the log level of mock objects from leaking between tests.
+The loggers of the go-logging messagelogger package share a system log level:
+messagelogger.SetLogLevel() changes every logger, and loggers created afterwards start at that level.
+Set the IsolatedLogger field of a mock object to give it a logger whose level only its SetLogLevel() changes,
+and call CheckLevels() in a test to detect a level changed by another test.
+*/
+package isolatedlogger
@@ -0,0 +1,150 @@
+package isolatedlogger
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/senzing/go-logging/logger"
+	"github.com/senzing/go-logging/messagelogger"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Leveler is implemented by the mock objects, which report the log level of their logger with LogLevel().
+type Leveler interface {
+	LogLevel() logger.Level
+}
+
+// The logger of an isolated message logger. It keeps its own level, whatever level it is set to.
+type levelLogger struct {
+	*logger.LoggerDefault
+	mutex sync.Mutex
+	level logger.Level
+}
+
+// A message logger whose level is changed only through its own SetLogLevel().
+type isolatedLogger struct {
+	messagelogger.MessageLoggerInterface
+	levelLogger *levelLogger
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function creates a message logger like messagelogger.NewSenzingApiLogger(),
+whose log level is changed only by its own SetLogLevel() and SetLogLevelFromString().
+The system log level set by messagelogger.SetLogLevel() is ignored.
+
+Input
+  - productId: The Senzing product identifier of the messages.
+  - idMessages: The messages, by message identifier.
+  - idStatuses: The statuses of messages, by message identifier.
+  - level: The initial log level.
+
+Output
+  - A message logger.
+  - An error from messagelogger.NewSenzingApiLogger().
+*/
+func New(productId int, idMessages map[int]string, idStatuses map[int]string, level messagelogger.Level) (messagelogger.MessageLoggerInterface, error) {
+	levelLogger := &levelLogger{
+		LoggerDefault: logger.New(),
+		level:         logger.Level(level),
+	}
+	messageLogger, err := messagelogger.NewSenzingApiLogger(productId, idMessages, idStatuses, level, levelLogger)
+	if err != nil {
+		return nil, err
+	}
+	return &isolatedLogger{
+		MessageLoggerInterface: messageLogger,
+		levelLogger:            levelLogger,
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// SetLogLevel applies the level of the logger, ignoring the level requested.
+// The messagelogger package propagates the system log level through this method.
+func (levelLogger *levelLogger) SetLogLevel(level logger.Level) logger.LoggerInterface {
+	levelLogger.mutex.Lock()
+	defer levelLogger.mutex.Unlock()
+	levelLogger.LoggerDefault.SetLogLevel(levelLogger.level)
+	return levelLogger
+}
+
+// SetLogLevelFromString applies the level of the logger, ignoring the level requested.
+func (levelLogger *levelLogger) SetLogLevelFromString(levelString string) logger.LoggerInterface {
+	return levelLogger.SetLogLevel(levelLogger.level)
+}
+
+// Change the level kept by the logger.
+func (levelLogger *levelLogger) setLevel(level logger.Level) {
+	levelLogger.mutex.Lock()
+	defer levelLogger.mutex.Unlock()
+	levelLogger.level = level
+}
+
+func (isolatedLogger *isolatedLogger) SetLogLevel(level messagelogger.Level) messagelogger.MessageLoggerInterface {
+	isolatedLogger.levelLogger.setLevel(logger.Level(level))
+	isolatedLogger.MessageLoggerInterface.SetLogLevel(level)
+	return isolatedLogger
+}
+
+// SetLogLevelFromString sets the level of the logger. Like go-logging, an unknown level name is PANIC.
+func (isolatedLogger *isolatedLogger) SetLogLevelFromString(levelString string) messagelogger.MessageLoggerInterface {
+	level, ok := logger.TextToLevelMap[levelString]
+	if !ok {
+		level = logger.LevelPanic
+	}
+	return isolatedLogger.SetLogLevel(messagelogger.Level(level))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Name a system log level, which is "unset" until messagelogger.SetLogLevel() is called.
+func levelName(level messagelogger.Level, err error) string {
+	if err != nil {
+		return "unset"
+	}
+	return logger.LevelToTextMap[logger.Level(level)]
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The CheckLevels function fails a test if, when the test completes, the system log level
+or the log level of a mock object differs from the level it had when CheckLevels() was called,
+e.g. because a parallel test changed the level of a shared mock object.
+A test that changes a level itself has to restore it.
+
+Input
+  - test: The test to fail.
+  - levelers: The mock objects to check.
+*/
+func CheckLevels(test testing.TB, levelers ...Leveler) {
+	test.Helper()
+	systemLevel, systemErr := messagelogger.GetLogLevel()
+	levels := make([]logger.Level, len(levelers))
+	for i, leveler := range levelers {
+		levels[i] = leveler.LogLevel()
+	}
+	test.Cleanup(func() {
+		if level, err := messagelogger.GetLogLevel(); (err == nil) != (systemErr == nil) || level != systemLevel {
+			test.Errorf("system log level leaked: %s at start, %s at end", levelName(systemLevel, systemErr), levelName(level, err))
+		}
+		for i, leveler := range levelers {
+			if level := leveler.LogLevel(); level != levels[i] {
+				test.Errorf("log level of %T leaked: %s at start, %s at end", leveler, logger.LevelToTextMap[levels[i]], logger.LevelToTextMap[level])
+			}
+		}
+	})
+}
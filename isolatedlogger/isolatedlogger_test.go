@@ -0,0 +1,85 @@
+package isolatedlogger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/senzing/go-logging/logger"
+	"github.com/senzing/go-logging/messagelogger"
+	"github.com/stretchr/testify/assert"
+)
+
+var idMessages = map[int]string{
+	4001: "Call to G2_addRecord(%s, %s) failed.",
+}
+
+// A mock object reporting a log level.
+type testLeveler struct {
+	level logger.Level
+}
+
+func (leveler *testLeveler) LogLevel() logger.Level {
+	return leveler.level
+}
+
+// A test recording the errors reported by CheckLevels().
+type testTB struct {
+	testing.TB
+	cleanups []func()
+	errors   []string
+}
+
+func (test *testTB) Helper() {}
+
+func (test *testTB) Cleanup(cleanup func()) {
+	test.cleanups = append(test.cleanups, cleanup)
+}
+
+func (test *testTB) Errorf(format string, args ...interface{}) {
+	test.errors = append(test.errors, fmt.Sprintf(format, args...))
+}
+
+func (test *testTB) finish() {
+	for _, cleanup := range test.cleanups {
+		cleanup()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNew(test *testing.T) {
+	isolated, err := New(9999, idMessages, map[int]string{}, messagelogger.LevelInfo)
+	assert.NoError(test, err)
+	shared, err := messagelogger.NewSenzingApiLogger(9999, idMessages, map[int]string{}, messagelogger.LevelInfo)
+	assert.NoError(test, err)
+	err = messagelogger.SetLogLevel(messagelogger.LevelDebug)
+	assert.NoError(test, err)
+	defer func() {
+		_ = messagelogger.SetLogLevel(messagelogger.LevelInfo)
+	}()
+	assert.Equal(test, messagelogger.LevelDebug, shared.GetLogLevel())
+	assert.Equal(test, messagelogger.LevelInfo, isolated.GetLogLevel())
+	isolated.SetLogLevel(messagelogger.LevelTrace)
+	assert.Equal(test, messagelogger.LevelTrace, isolated.GetLogLevel())
+	assert.True(test, isolated.IsTrace())
+	isolated.SetLogLevelFromString(logger.LevelWarnName)
+	assert.Equal(test, messagelogger.LevelWarn, isolated.GetLogLevel())
+	assert.False(test, isolated.IsInfo())
+	err = isolated.Error(4001, "CUSTOMERS", "1001")
+	assert.ErrorContains(test, err, "Call to G2_addRecord(CUSTOMERS, 1001) failed.")
+}
+
+func TestCheckLevels(test *testing.T) {
+	leveler := &testLeveler{level: logger.LevelInfo}
+	unchanged := &testTB{}
+	CheckLevels(unchanged, leveler)
+	unchanged.finish()
+	assert.Empty(test, unchanged.errors)
+	changed := &testTB{}
+	CheckLevels(changed, leveler)
+	leveler.level = logger.LevelDebug
+	changed.finish()
+	assert.Equal(test, []string{"log level of *isolatedlogger.testLeveler leaked: INFO at start, DEBUG at end"}, changed.errors)
+}
@@ -0,0 +1,15 @@
+/*
+The mockerror package describes the errors returned by the mock objects with machine-readable fields,
+so tests of error handling can assert on the message number, method and identifiers of an error
+rather than on its text, e.g.
+
+	var mockError *mockerror.Error
+	if errors.As(err, &mockError) && mockError.Code == 4916 {
+		recordID := mockError.Identifiers["recordID"]
+	}
+
+The Class of an error tells whether a caller may retry it, see IsRetryable() and IsBadInput().
+NewSenzing() creates errors like those of the Senzing engine, e.g. "0033E|Unknown record",
+to configure the errors returned by the mock objects.
+*/
+package mockerror
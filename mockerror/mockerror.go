@@ -0,0 +1,102 @@
+package mockerror
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Error is an error created by a mock object. Its text is that of the wrapped error.
+type Error struct {
+	Code        int               // Message number, e.g. 4916 for an unknown record in g2engine.
+	Method      string            // Method that returned the error, e.g. "GetRecord". Empty if the error is not returned by a single method.
+	Identifiers map[string]string // Identifiers of the call, by argument name, e.g. {"dataSourceCode": "CUSTOMERS", "recordID": "1001"}.
+	Class       Class             // How a caller should handle the error, e.g. ClassRetryable. ClassUnknown if not classified.
+	SenzingCode string            // Senzing error code, e.g. "0033E". Empty unless created by NewSenzing().
+	err         error
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function describes an error created by a mock object.
+
+Input
+  - err: The error, usually created by the logger of the mock object.
+  - code: The message number of the error.
+  - method: The method returning the error.
+  - identifiers: The identifiers of the call, by argument name. May be nil.
+
+Output
+  - The error, or nil if err is nil.
+*/
+func New(err error, code int, method string, identifiers map[string]string) error {
+	if err == nil {
+		return nil
+	}
+	if identifiers == nil {
+		identifiers = map[string]string{}
+	}
+	return &Error{
+		Code:        code,
+		Method:      method,
+		Identifiers: identifiers,
+		err:         err,
+	}
+}
+
+/*
+The Classify function sets the class of an *Error, e.g. to mark the errors of a mock object that a caller may retry.
+
+Input
+  - err: An error created by New().
+  - class: How a caller should handle the error.
+
+Output
+  - err. Errors that are not an *Error are returned unchanged.
+*/
+func Classify(err error, class Class) error {
+	if mockError, ok := err.(*Error); ok {
+		mockError.Class = class
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+// Error returns the text of the wrapped error.
+func (mockError *Error) Error() string {
+	return mockError.err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (mockError *Error) Unwrap() error {
+	return mockError.err
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Code function returns the message number of the first *Error in the chain of err.
+
+Input
+  - err: An error returned by a mock object.
+
+Output
+  - The message number, or 0 if err is not, and does not wrap, an *Error.
+*/
+func Code(err error) int {
+	var mockError *Error
+	if !errors.As(err, &mockError) {
+		return 0
+	}
+	return mockError.Code
+}
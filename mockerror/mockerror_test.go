@@ -0,0 +1,53 @@
+package mockerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(test *testing.T) {
+	err := New(errors.New("unknown record"), 4916, "GetRecord", map[string]string{"recordID": "1001"})
+	assert.EqualError(test, err, "unknown record")
+	var mockError *Error
+	assert.True(test, errors.As(fmt.Errorf("wrapped: %w", err), &mockError))
+	assert.Equal(test, 4916, mockError.Code)
+	assert.Equal(test, "GetRecord", mockError.Method)
+	assert.Equal(test, "1001", mockError.Identifiers["recordID"])
+	assert.Nil(test, New(nil, 4916, "GetRecord", nil))
+	assert.NotNil(test, New(errors.New("failed"), 1, "", nil).(*Error).Identifiers)
+}
+
+func TestCode(test *testing.T) {
+	err := New(errors.New("unknown record"), 4916, "GetRecord", nil)
+	assert.Equal(test, 4916, Code(fmt.Errorf("wrapped: %w", err)))
+	assert.Equal(test, 0, Code(errors.New("plain")))
+	assert.Equal(test, 0, Code(nil))
+}
+
+func TestClassify(test *testing.T) {
+	err := Classify(New(errors.New("busy"), 4920, "AddRecord", nil), ClassRetryable)
+	assert.True(test, IsRetryable(fmt.Errorf("wrapped: %w", err)))
+	assert.False(test, IsBadInput(err))
+	plain := errors.New("plain")
+	assert.Equal(test, plain, Classify(plain, ClassRetryable))
+	assert.Equal(test, ClassUnknown, ClassOf(plain))
+}
+
+func TestNewSenzing(test *testing.T) {
+	err := NewSenzing(UnknownRecord, "GetRecord", map[string]string{"recordID": "1001"})
+	assert.EqualError(test, err, "0033E|Unknown record")
+	assert.Equal(test, 33, Code(err))
+	assert.Equal(test, ClassNotFound, ClassOf(err))
+	assert.True(test, IsBadInput(err))
+	assert.Equal(test, UnknownRecord, err.(*Error).SenzingCode)
+	assert.True(test, IsRetryable(NewSenzing(DatabaseConnectionLost, "AddRecord", nil)))
+	assert.Equal(test, ClassUnknown, ClassOf(NewSenzing("7426E", "AddRecord", nil)))
+}
+
+func TestClass_String(test *testing.T) {
+	assert.Equal(test, "retryable", ClassRetryable.String())
+	assert.Equal(test, "class 99", Class(99).String())
+}
@@ -0,0 +1,165 @@
+package mockerror
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Class groups errors by how a caller should handle them, like the error types of the Senzing SDKs.
+type Class int
+
+// A Senzing error code known to NewSenzing().
+type senzingError struct {
+	class   Class
+	message string
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// ClassUnknown is the class of errors that are not classified.
+	ClassUnknown Class = iota
+
+	// ClassBadInput is the class of errors caused by the arguments of a call. Retrying the call fails again.
+	ClassBadInput
+
+	// ClassNotFound is the class of errors for unknown records and entities. It is a kind of ClassBadInput.
+	ClassNotFound
+
+	// ClassRetryable is the class of errors of calls that may succeed if retried, e.g. after a lost database connection.
+	ClassRetryable
+
+	// ClassUnrecoverable is the class of errors after which the engine cannot be used, e.g. a license limit.
+	ClassUnrecoverable
+)
+
+// Senzing error codes known to NewSenzing().
+const (
+	ConflictingDataSource  = "0023E"
+	UnknownDataSource      = "0027E"
+	UnknownRecord          = "0033E"
+	UnknownEntity          = "0037E"
+	NotInitialized         = "0048E"
+	DatabaseConnectionLost = "1006E"
+	DatabaseConnectionFail = "1007E"
+	LicenseLimitExceeded   = "9000E"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// The class and message of Senzing error codes, by code.
+var senzingErrors = map[string]senzingError{
+	ConflictingDataSource:  {ClassBadInput, "Conflicting DATA_SOURCE values"},
+	UnknownDataSource:      {ClassBadInput, "Unknown DATA_SOURCE value"},
+	UnknownRecord:          {ClassNotFound, "Unknown record"},
+	UnknownEntity:          {ClassNotFound, "Unknown resolved entity value"},
+	NotInitialized:         {ClassUnrecoverable, "G2 is not initialized"},
+	DatabaseConnectionLost: {ClassRetryable, "Database connection lost"},
+	DatabaseConnectionFail: {ClassRetryable, "Database connection failure"},
+	LicenseLimitExceeded:   {ClassUnrecoverable, "License limit exceeded"},
+}
+
+// The names of the classes, by class.
+var classNames = map[Class]string{
+	ClassUnknown:       "unknown",
+	ClassBadInput:      "bad input",
+	ClassNotFound:      "not found",
+	ClassRetryable:     "retryable",
+	ClassUnrecoverable: "unrecoverable",
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewSenzing function creates an error like those returned by the Senzing engine for an error code,
+e.g. to configure the errors returned by the methods of a mock object:
+
+	g2engine.Errors = map[string]error{"GetRecord": mockerror.NewSenzing(mockerror.UnknownRecord, "GetRecord", nil)}
+
+Input
+  - senzingCode: The Senzing error code, e.g. UnknownRecord or "0033E".
+  - method: The method returning the error.
+  - identifiers: The identifiers of the call, by argument name. May be nil.
+
+Output
+  - An *Error with the class of the code, or ClassUnknown if the code is not one of the constants of this package.
+    Its text is the code and message, e.g. "0033E|Unknown record", and its Code the number of the code, e.g. 33.
+*/
+func NewSenzing(senzingCode string, method string, identifiers map[string]string) error {
+	known, ok := senzingErrors[senzingCode]
+	if !ok {
+		known = senzingError{ClassUnknown, "Senzing error"}
+	}
+	code, _ := strconv.Atoi(strings.TrimRight(senzingCode, "EWI"))
+	err := New(fmt.Errorf("%s|%s", senzingCode, known.message), code, method, identifiers)
+	mockError := err.(*Error)
+	mockError.Class = known.class
+	mockError.SenzingCode = senzingCode
+	return mockError
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// String returns the name of the class, e.g. "retryable".
+func (class Class) String() string {
+	if name, ok := classNames[class]; ok {
+		return name
+	}
+	return fmt.Sprintf("class %d", int(class))
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ClassOf function returns the class of the first *Error in the chain of err.
+
+Input
+  - err: An error returned by a mock object.
+
+Output
+  - The class, or ClassUnknown if err is not, and does not wrap, an *Error.
+*/
+func ClassOf(err error) Class {
+	var mockError *Error
+	if !errors.As(err, &mockError) {
+		return ClassUnknown
+	}
+	return mockError.Class
+}
+
+/*
+The IsRetryable function reports whether a call returning err may succeed if retried.
+
+Input
+  - err: An error returned by a mock object.
+*/
+func IsRetryable(err error) bool {
+	return ClassOf(err) == ClassRetryable
+}
+
+/*
+The IsBadInput function reports whether err was caused by the arguments of the call, including unknown records and entities.
+
+Input
+  - err: An error returned by a mock object.
+*/
+func IsBadInput(err error) bool {
+	class := ClassOf(err)
+	return class == ClassBadInput || class == ClassNotFound
+}
@@ -0,0 +1,6 @@
+/*
+The notification package encodes the messages the mock objects send to registered observers.
+Set the NotificationEncoder field of a mock object to JSON, Protobuf or CloudEvents
+to choose the encoding. The default is JSON, the format of the g2-sdk-go-base implementation.
+*/
+package notification
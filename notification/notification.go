@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Encoder converts the details of a notification into the message sent to observers.
+// The details include "subjectId", "messageId" and "messageTime", in nanoseconds since the Unix epoch.
+type Encoder func(details map[string]string) (string, error)
+
+// A CloudEvents 1.0 event in structured content mode.
+type cloudEventJson struct {
+	SpecVersion     string            `json:"specversion"`
+	ID              string            `json:"id"`
+	Source          string            `json:"source"`
+	Type            string            `json:"type"`
+	Time            string            `json:"time,omitempty"`
+	DataContentType string            `json:"datacontenttype"`
+	Data            map[string]string `json:"data"`
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Prefix of the "type" attribute of CloudEvents. The messageId is appended.
+var CloudEventsTypePrefix = "com.senzing.notification."
+
+// Prefix of the "source" attribute of CloudEvents. The subjectId is appended.
+var CloudEventsSourcePrefix = "/senzing/"
+
+// Number of CloudEvents encoded, used to make their "id" attributes unique.
+var cloudEventSequence int64
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Append a length-delimited protobuf field.
+func appendProtobufBytes(buffer []byte, fieldNumber uint64, value []byte) []byte {
+	buffer = binary.AppendUvarint(buffer, fieldNumber<<3|2)
+	buffer = binary.AppendUvarint(buffer, uint64(len(value)))
+	return append(buffer, value...)
+}
+
+// ----------------------------------------------------------------------------
+// Encoders
+// ----------------------------------------------------------------------------
+
+// JSON encodes the details as a flat JSON object.
+func JSON(details map[string]string) (string, error) {
+	message, err := json.Marshal(details)
+	return string(message), err
+}
+
+/*
+Protobuf encodes the details in the protobuf wire format of the following message,
+with the entries ordered by key:
+
+	message Notification {
+	  map<string, string> details = 1;
+	}
+*/
+func Protobuf(details map[string]string) (string, error) {
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	message := []byte{}
+	for _, key := range keys {
+		entry := appendProtobufBytes(nil, 1, []byte(key))
+		entry = appendProtobufBytes(entry, 2, []byte(details[key]))
+		message = appendProtobufBytes(message, 1, entry)
+	}
+	return string(message), nil
+}
+
+// CloudEvents encodes the details as the "data" of a CloudEvents 1.0 event in structured JSON mode.
+func CloudEvents(details map[string]string) (string, error) {
+	event := cloudEventJson{
+		SpecVersion:     "1.0",
+		ID:              strconv.FormatInt(atomic.AddInt64(&cloudEventSequence, 1), 10),
+		Source:          CloudEventsSourcePrefix + details["subjectId"],
+		Type:            CloudEventsTypePrefix + details["messageId"],
+		DataContentType: "application/json",
+		Data:            details,
+	}
+	if nanoseconds, err := strconv.ParseInt(details["messageTime"], 10, 64); err == nil {
+		event.Time = time.Unix(0, nanoseconds).UTC().Format(time.RFC3339Nano)
+		event.ID = details["messageTime"] + "-" + event.ID
+	}
+	message, err := json.Marshal(event)
+	return string(message), err
+}
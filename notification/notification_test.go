@@ -0,0 +1,58 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestJSON(test *testing.T) {
+	actual, err := JSON(map[string]string{"messageId": "8001", "subjectId": "6034"})
+	assert.NoError(test, err)
+	assert.Equal(test, `{"messageId":"8001","subjectId":"6034"}`, actual)
+}
+
+func TestProtobuf(test *testing.T) {
+	actual, err := Protobuf(map[string]string{"b": "2", "a": "1"})
+	assert.NoError(test, err)
+	assert.Equal(test, "\x0a\x06\x0a\x01a\x12\x011\x0a\x06\x0a\x01b\x12\x012", actual)
+}
+
+func TestCloudEvents(test *testing.T) {
+	actual, err := CloudEvents(map[string]string{"messageId": "8001", "messageTime": "1000000000", "subjectId": "6034"})
+	assert.NoError(test, err)
+	event := map[string]interface{}{}
+	err = json.Unmarshal([]byte(actual), &event)
+	assert.NoError(test, err)
+	assert.Equal(test, "1.0", event["specversion"])
+	assert.Equal(test, "/senzing/6034", event["source"])
+	assert.Equal(test, "com.senzing.notification.8001", event["type"])
+	assert.Equal(test, "1970-01-01T00:00:01Z", event["time"])
+	assert.Equal(test, "8001", event["data"].(map[string]interface{})["messageId"])
+	other, err := CloudEvents(map[string]string{"messageId": "8001", "messageTime": "1000000000", "subjectId": "6034"})
+	assert.NoError(test, err)
+	otherEvent := map[string]interface{}{}
+	err = json.Unmarshal([]byte(other), &otherEvent)
+	assert.NoError(test, err)
+	assert.NotEqual(test, event["id"], otherEvent["id"])
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleJSON() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/notification/notification_test.go
+	message, err := JSON(map[string]string{"messageId": "8001", "subjectId": "6034"})
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(message)
+	// Output: {"messageId":"8001","subjectId":"6034"}
+}
@@ -0,0 +1,7 @@
+/*
+The recorder package captures the calls made to mock objects.
+Set the Recorder field of a G2engine, G2configmgr or G2product to a Recorder
+to assert that the code under test called the SDK with the expected parameters.
+One Recorder may be shared by several mock objects to check the order of their calls.
+*/
+package recorder
@@ -0,0 +1,112 @@
+package recorder
+
+import (
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Call is an invocation of a method of a mock object.
+type Call struct {
+	Component string        // The package of the mock object, e.g. "g2engine".
+	Method    string        // The name of the method, e.g. "AddRecord".
+	Arguments []interface{} // The arguments, in order, excluding the context.
+	Time      time.Time     // When the method was called.
+}
+
+// Recorder captures the calls made to the mock objects it is set on. It is safe for concurrent use.
+type Recorder struct {
+	mutex sync.Mutex
+	calls []Call
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// New returns an empty Recorder.
+func New() *Recorder {
+	return &Recorder{}
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The Record method captures a call. It is called by the mock objects and does nothing on a nil Recorder.
+
+Input
+  - component: The package of the mock object, e.g. "g2engine".
+  - method: The name of the method, e.g. "AddRecord".
+  - arguments: The arguments, in order, excluding the context.
+*/
+func (recorder *Recorder) Record(component string, method string, arguments ...interface{}) {
+	if recorder == nil {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.calls = append(recorder.calls, Call{
+		Component: component,
+		Method:    method,
+		Arguments: arguments,
+		Time:      time.Now(),
+	})
+}
+
+/*
+The Calls method returns the calls captured since the Recorder was created or Reset().
+
+Output
+  - The calls, in the order they were made.
+*/
+func (recorder *Recorder) Calls() []Call {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return append([]Call{}, recorder.calls...)
+}
+
+/*
+The CallsTo method returns the calls of a method captured since the Recorder was created or Reset().
+
+Input
+  - method: The name of the method, e.g. "AddRecord".
+
+Output
+  - The calls of the method of any mock object, in the order they were made.
+*/
+func (recorder *Recorder) CallsTo(method string) []Call {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	result := []Call{}
+	for _, call := range recorder.calls {
+		if call.Method == method {
+			result = append(result, call)
+		}
+	}
+	return result
+}
+
+/*
+The CallCount method returns the number of calls of a method captured since the Recorder was created or Reset().
+
+Input
+  - method: The name of the method, e.g. "AddRecord".
+
+Output
+  - The number of calls of the method of any mock object.
+*/
+func (recorder *Recorder) CallCount(method string) int {
+	return len(recorder.CallsTo(method))
+}
+
+// The Reset method forgets the calls captured.
+func (recorder *Recorder) Reset() {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.calls = nil
+}
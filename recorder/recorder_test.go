@@ -0,0 +1,51 @@
+package recorder
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRecorder_Record(test *testing.T) {
+	recorder := New()
+	recorder.Record("g2engine", "AddRecord", "CUSTOMERS", "1001", `{}`, "LOAD-1")
+	recorder.Record("g2product", "Version")
+	recorder.Record("g2engine", "AddRecord", "CUSTOMERS", "1002", `{}`, "LOAD-1")
+	calls := recorder.Calls()
+	assert.Len(test, calls, 3)
+	assert.Equal(test, "g2product", calls[1].Component)
+	assert.Equal(test, "Version", calls[1].Method)
+	assert.Empty(test, calls[1].Arguments)
+	assert.False(test, calls[2].Time.Before(calls[0].Time))
+	assert.Equal(test, 2, recorder.CallCount("AddRecord"))
+	assert.Equal(test, []interface{}{"CUSTOMERS", "1002", `{}`, "LOAD-1"}, recorder.CallsTo("AddRecord")[1].Arguments)
+	assert.Equal(test, 0, recorder.CallCount("DeleteRecord"))
+	recorder.Reset()
+	assert.Empty(test, recorder.Calls())
+}
+
+func TestRecorder_Record_nil(test *testing.T) {
+	var recorder *Recorder
+	assert.NotPanics(test, func() {
+		recorder.Record("g2engine", "AddRecord")
+	})
+}
+
+func TestRecorder_Record_concurrent(test *testing.T) {
+	recorder := New()
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			recorder.Record("g2engine", "GetActiveConfigID")
+		}()
+	}
+	waitGroup.Wait()
+	assert.Equal(test, 10, recorder.CallCount("GetActiveConfigID"))
+}
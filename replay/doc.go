@@ -0,0 +1,6 @@
+/*
+The replay package replays a mix of G2engine calls, weighted like those of a production workload,
+at a fixed rate. It is used for capacity-planning tests of services that wrap the Senzing SDK,
+with the g2engine mock standing in for Senzing.
+*/
+package replay
@@ -0,0 +1,201 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Mix holds the relative frequency of each method, e.g. {"AddRecord": 900, "GetEntityByRecordID": 100}.
+type Mix map[string]float64
+
+// Options control a replay.
+type Options struct {
+	Calls       int     // Number of calls made.
+	Rate        float64 // Calls started per second. 0 is as fast as possible.
+	Concurrency int     // Number of calls in flight at once. 0 is 1.
+	Seed        int64   // Seed of the pseudo-random choice of methods.
+}
+
+// Report summarizes a replay.
+type Report struct {
+	Calls   map[string]int // Calls made, by method.
+	Errors  map[string]int // Calls that returned an error, by method.
+	Elapsed time.Duration  // Time from the first call to the end of the last.
+}
+
+// An entry of an interaction archive.
+type archiveEntryJson struct {
+	Method string `json:"method"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the methods of the mix that G2engine has, sorted, and their cumulative weights.
+func cumulativeWeights(mix Mix) ([]string, []float64, error) {
+	engineType := reflect.TypeOf((*g2api.G2engine)(nil)).Elem()
+	methods := []string{}
+	for method, weight := range mix {
+		if _, ok := engineType.MethodByName(method); !ok {
+			return nil, nil, fmt.Errorf("G2engine has no method %s", method)
+		}
+		if weight > 0 {
+			methods = append(methods, method)
+		}
+	}
+	if len(methods) == 0 {
+		return nil, nil, fmt.Errorf("the mix has no method with a positive weight")
+	}
+	sort.Strings(methods)
+	weights := make([]float64, len(methods))
+	total := 0.0
+	for i, method := range methods {
+		total += mix[method]
+		weights[i] = total
+	}
+	return methods, weights, nil
+}
+
+// Choose a method with probability proportional to its weight.
+func choose(random *rand.Rand, methods []string, weights []float64) string {
+	target := random.Float64() * weights[len(weights)-1]
+	index := sort.SearchFloat64s(weights, target)
+	if index >= len(methods) {
+		index = len(methods) - 1
+	}
+	return methods[index]
+}
+
+// Call a G2engine method with zero-valued arguments. Returns the error it returns, if any.
+func call(ctx context.Context, g2engine g2api.G2engine, method string) error {
+	function := reflect.ValueOf(g2engine).MethodByName(method)
+	arguments := make([]reflect.Value, function.Type().NumIn())
+	for i := range arguments {
+		argumentType := function.Type().In(i)
+		if argumentType.Implements(reflect.TypeOf((*context.Context)(nil)).Elem()) {
+			arguments[i] = reflect.ValueOf(ctx)
+		} else {
+			arguments[i] = reflect.Zero(argumentType)
+		}
+	}
+	results := function.Call(arguments)
+	if len(results) == 0 {
+		return nil
+	}
+	if err, ok := results[len(results)-1].Interface().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The MixFromArchive function counts the calls of each method in an interaction archive:
+JSON Lines in which each entry has a "method", e.g. {"method":"AddRecord"}.
+Only the methods are read, so the rate of the archived calls is not reproduced by Replay();
+it is set with the Rate option.
+
+Input
+  - reader: The source of the archive.
+
+Output
+  - The number of calls of each method.
+*/
+func MixFromArchive(reader io.Reader) (Mix, error) {
+	result := Mix{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		entry := archiveEntryJson{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, err
+		}
+		result[entry.Method]++
+	}
+	return result, scanner.Err()
+}
+
+/*
+The Replay function calls G2engine methods, chosen at random with the weights of the mix, at the rate of the options.
+Methods are called with the context and zero values for the other arguments,
+which the g2engine mock accepts. Errors returned by the methods are counted, not returned.
+
+Input
+  - ctx: A context to control lifecycle. Cancelling it stops the replay.
+  - g2engine: The G2engine called, usually a g2engine mock.
+  - mix: The relative frequency of each method.
+  - options: The number, rate and concurrency of calls.
+
+Output
+  - The calls made and the errors returned, by method.
+*/
+func Replay(ctx context.Context, g2engine g2api.G2engine, mix Mix, options Options) (Report, error) {
+	report := Report{
+		Calls:  map[string]int{},
+		Errors: map[string]int{},
+	}
+	methods, weights, err := cumulativeWeights(mix)
+	if err != nil {
+		return report, err
+	}
+	concurrency := options.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	random := rand.New(rand.NewSource(options.Seed))
+	var interval time.Duration
+	if options.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / options.Rate)
+	}
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	startTime := time.Now()
+	for i := 0; i < options.Calls && ctx.Err() == nil; i++ {
+		if interval > 0 {
+			select {
+			case <-time.After(time.Until(startTime.Add(time.Duration(i) * interval))):
+			case <-ctx.Done():
+				continue
+			}
+		}
+		method := choose(random, methods, weights)
+		slots <- struct{}{}
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			err := call(ctx, g2engine, method)
+			<-slots
+			mutex.Lock()
+			defer mutex.Unlock()
+			report.Calls[method]++
+			if err != nil {
+				report.Errors[method]++
+			}
+		}()
+	}
+	waitGroup.Wait()
+	report.Elapsed = time.Since(startTime)
+	return report, ctx.Err()
+}
@@ -0,0 +1,71 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/g2engine"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestMixFromArchive(test *testing.T) {
+	archive := `{"method":"AddRecord"}
+{"method":"AddRecord"}
+
+{"method":"GetEntityByRecordID"}
+`
+	actual, err := MixFromArchive(strings.NewReader(archive))
+	assert.NoError(test, err)
+	assert.Equal(test, Mix{"AddRecord": 2, "GetEntityByRecordID": 1}, actual)
+}
+
+func TestReplay(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &g2engine.G2engine{}
+	mix := Mix{"AddRecord": 3, "GetEntityByRecordID": 1, "SearchByAttributes": 0}
+	actual, err := Replay(ctx, g2engine, mix, Options{Calls: 400, Concurrency: 4, Seed: 1})
+	assert.NoError(test, err)
+	assert.Equal(test, 400, actual.Calls["AddRecord"]+actual.Calls["GetEntityByRecordID"])
+	assert.InDelta(test, 300, actual.Calls["AddRecord"], 40)
+	assert.Zero(test, actual.Calls["SearchByAttributes"])
+	assert.Empty(test, actual.Errors)
+}
+
+func TestReplay_rate(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &g2engine.G2engine{}
+	actual, err := Replay(ctx, g2engine, Mix{"CountRedoRecords": 1}, Options{Calls: 5, Rate: 100})
+	assert.NoError(test, err)
+	assert.Equal(test, 5, actual.Calls["CountRedoRecords"])
+	assert.GreaterOrEqual(test, actual.Elapsed, 40*time.Millisecond)
+}
+
+func TestReplay_unknownMethod(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := &g2engine.G2engine{}
+	_, err := Replay(ctx, g2engine, Mix{"NoSuchMethod": 1}, Options{Calls: 1})
+	assert.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleReplay() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/replay/replay_test.go
+	ctx := context.TODO()
+	g2engine := &g2engine.G2engine{}
+	report, err := Replay(ctx, g2engine, Mix{"AddRecord": 1}, Options{Calls: 10})
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(report.Calls["AddRecord"])
+	// Output: 10
+}
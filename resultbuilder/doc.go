@@ -0,0 +1,5 @@
+/*
+The resultbuilder package is used to compose the JSON documents returned by the mock objects,
+so tests do not need to copy large example strings into "...Result" fields.
+*/
+package resultbuilder
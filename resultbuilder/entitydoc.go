@@ -0,0 +1,310 @@
+package resultbuilder
+
+import (
+	"fmt"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Record describes a record within a RESOLVED_ENTITY.
+type Record struct {
+	DataSource     string `json:"DATA_SOURCE"`
+	RecordID       string `json:"RECORD_ID"`
+	EntityType     string `json:"ENTITY_TYPE"`
+	InternalID     int64  `json:"INTERNAL_ID"`
+	EntityKey      string `json:"ENTITY_KEY"`
+	EntityDesc     string `json:"ENTITY_DESC"`
+	MatchKey       string `json:"MATCH_KEY"`
+	MatchLevel     int    `json:"MATCH_LEVEL"`
+	MatchLevelCode string `json:"MATCH_LEVEL_CODE"`
+	ErruleCode     string `json:"ERRULE_CODE"`
+	FirstSeenDt    string `json:"FIRST_SEEN_DT,omitempty"`
+	LastSeenDt     string `json:"LAST_SEEN_DT"`
+}
+
+// RecordSummary describes the records of one data source within an entity.
+type RecordSummary struct {
+	DataSource  string `json:"DATA_SOURCE"`
+	RecordCount int    `json:"RECORD_COUNT"`
+	FirstSeenDt string `json:"FIRST_SEEN_DT"`
+	LastSeenDt  string `json:"LAST_SEEN_DT"`
+}
+
+// RelatedEntity describes an entry in RELATED_ENTITIES.
+type RelatedEntity struct {
+	EntityID       int64           `json:"ENTITY_ID"`
+	MatchLevel     int             `json:"MATCH_LEVEL"`
+	MatchLevelCode string          `json:"MATCH_LEVEL_CODE"`
+	MatchKey       string          `json:"MATCH_KEY"`
+	ErruleCode     string          `json:"ERRULE_CODE"`
+	IsDisclosed    int             `json:"IS_DISCLOSED"`
+	IsAmbiguous    int             `json:"IS_AMBIGUOUS"`
+	EntityName     string          `json:"ENTITY_NAME"`
+	RecordSummary  []RecordSummary `json:"RECORD_SUMMARY"`
+	LastSeenDt     string          `json:"LAST_SEEN_DT"`
+}
+
+// ResolvedEntity describes a RESOLVED_ENTITY.
+type ResolvedEntity struct {
+	EntityID      int64                          `json:"ENTITY_ID"`
+	EntityName    string                         `json:"ENTITY_NAME"`
+	Features      map[string][]map[string]string `json:"FEATURES"`
+	RecordSummary []RecordSummary                `json:"RECORD_SUMMARY"`
+	LastSeenDt    string                         `json:"LAST_SEEN_DT"`
+	Records       []Record                       `json:"RECORDS"`
+}
+
+// RecordsPage describes the page of RECORDS in a document of an entity split into pages with PageSize().
+type RecordsPage struct {
+	Page             int    `json:"PAGE"`
+	PageCount        int    `json:"PAGE_COUNT"`
+	PageSize         int    `json:"PAGE_SIZE"`
+	TotalRecordCount int    `json:"TOTAL_RECORD_COUNT"`
+	Continuation     string `json:"CONTINUATION"`
+}
+
+// EntityDoc builds the documents returned by GetEntityByEntityID() and GetEntityByRecordID().
+type EntityDoc struct {
+	entity   ResolvedEntity
+	lastSeen time.Time
+	pageSize int
+	related  []RelatedEntity
+}
+
+type entityDocJson struct {
+	ResolvedEntity  ResolvedEntity  `json:"RESOLVED_ENTITY"`
+	RelatedEntities []RelatedEntity `json:"RELATED_ENTITIES"`
+}
+
+type entityPageJson struct {
+	entityDocJson
+	RecordsPage RecordsPage `json:"RECORDS_PAGE"`
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// NewEntityDoc returns an empty entity document builder.
+func NewEntityDoc() *EntityDoc {
+	return &EntityDoc{
+		entity: ResolvedEntity{
+			Features: map[string][]map[string]string{},
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+// EntityID sets the ENTITY_ID of the resolved entity.
+func (doc *EntityDoc) EntityID(entityID int64) *EntityDoc {
+	doc.entity.EntityID = entityID
+	return doc
+}
+
+// Name sets the ENTITY_NAME of the resolved entity and adds it as a NAME feature.
+func (doc *EntityDoc) Name(name string) *EntityDoc {
+	doc.entity.EntityName = name
+	return doc.Feature("NAME", name)
+}
+
+// Feature adds a FEATURES value of the given feature type.
+func (doc *EntityDoc) Feature(featureType string, value string) *EntityDoc {
+	doc.entity.Features[featureType] = append(doc.entity.Features[featureType], map[string]string{
+		"FEAT_DESC": value,
+	})
+	return doc
+}
+
+// LastSeen sets the LAST_SEEN_DT of the entity and of records added afterwards.
+func (doc *EntityDoc) LastSeen(lastSeen time.Time) *EntityDoc {
+	doc.lastSeen = lastSeen
+	doc.entity.LastSeenDt = FormatTimestamp(lastSeen)
+	return doc
+}
+
+// LastSeenNow sets the LAST_SEEN_DT of the entity and of records added afterwards to the current time of the Clock option.
+func (doc *EntityDoc) LastSeenNow() *EntityDoc {
+	return doc.LastSeen(Now())
+}
+
+// AddRecord adds a record to RECORDS.
+// Empty ENTITY_TYPE, INTERNAL_ID, ENTITY_KEY, MATCH_LEVEL_CODE and LAST_SEEN_DT values are filled in.
+// ENTITY_KEY is derived with the EntityKeyFunc option.
+func (doc *EntityDoc) AddRecord(record Record) *EntityDoc {
+	if len(record.EntityType) == 0 {
+		record.EntityType = "GENERIC"
+	}
+	if len(record.EntityKey) == 0 {
+		record.EntityKey = options().EntityKeyFunc(record)
+	}
+	if record.InternalID == 0 {
+		record.InternalID = int64(len(doc.entity.Records) + 1)
+	}
+	if len(record.MatchLevelCode) == 0 {
+		record.MatchLevelCode = MatchLevelCodes[record.MatchLevel]
+	}
+	if len(record.LastSeenDt) == 0 {
+		record.LastSeenDt = doc.entity.LastSeenDt
+	}
+	doc.entity.Records = append(doc.entity.Records, record)
+	return doc
+}
+
+// PageSize splits RECORDS into pages of at most pageSize records, as an engine configured with a limit
+// of records per entity would. JSON() then renders the first page. A pageSize of 0 disables paging.
+func (doc *EntityDoc) PageSize(pageSize int) *EntityDoc {
+	doc.pageSize = pageSize
+	return doc
+}
+
+// AddRelated adds an entity to RELATED_ENTITIES.
+// An empty MATCH_LEVEL_CODE is derived from MATCH_LEVEL.
+func (doc *EntityDoc) AddRelated(related RelatedEntity) *EntityDoc {
+	if len(related.MatchLevelCode) == 0 {
+		related.MatchLevelCode = MatchLevelCodes[related.MatchLevel]
+	}
+	if related.RecordSummary == nil {
+		related.RecordSummary = []RecordSummary{}
+	}
+	doc.related = append(doc.related, related)
+	return doc
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// ResolvedEntity returns the RESOLVED_ENTITY, with RECORD_SUMMARY derived from RECORDS.
+func (doc *EntityDoc) ResolvedEntity() ResolvedEntity {
+	result := doc.entity
+	if result.Records == nil {
+		result.Records = []Record{}
+	}
+	result.RecordSummary = summarizeRecords(result.Records)
+	return result
+}
+
+// JSON renders the document returned by GetEntityByEntityID() and GetEntityByRecordID().
+// When the records are split into pages, it renders the first page.
+func (doc *EntityDoc) JSON() string {
+	if doc.pageSize > 0 {
+		return doc.PageJSON(0)
+	}
+	return render(doc.document())
+}
+
+// PageCount returns the number of pages of RECORDS. It is 1 when paging is disabled.
+func (doc *EntityDoc) PageCount() int {
+	if doc.pageSize <= 0 || len(doc.entity.Records) == 0 {
+		return 1
+	}
+	return (len(doc.entity.Records) + doc.pageSize - 1) / doc.pageSize
+}
+
+// PageJSON renders a page, numbered from 0, of the document.
+// RECORDS holds the records of the page, RECORD_SUMMARY still summarizes all records,
+// and RECORDS_PAGE holds the continuation marker of the next page, "" on the last page.
+// A negative page renders the first page.
+func (doc *EntityDoc) PageJSON(page int) string {
+	if page < 0 {
+		page = 0
+	}
+	result := entityPageJson{
+		entityDocJson: doc.document(),
+		RecordsPage: RecordsPage{
+			Page:             page,
+			PageCount:        doc.PageCount(),
+			PageSize:         doc.pageSize,
+			TotalRecordCount: len(doc.entity.Records),
+		},
+	}
+	if doc.pageSize > 0 {
+		records := result.ResolvedEntity.Records
+		first := page * doc.pageSize
+		if first > len(records) {
+			first = len(records)
+		}
+		last := first + doc.pageSize
+		if last > len(records) {
+			last = len(records)
+		}
+		result.ResolvedEntity.Records = records[first:last]
+	}
+	if page+1 < result.RecordsPage.PageCount {
+		result.RecordsPage.Continuation = Continuation(doc.entity.EntityID, page+1)
+	}
+	return render(result)
+}
+
+// Assemble the RESOLVED_ENTITY and RELATED_ENTITIES sections.
+func (doc *EntityDoc) document() entityDocJson {
+	result := entityDocJson{
+		ResolvedEntity:  doc.ResolvedEntity(),
+		RelatedEntities: doc.related,
+	}
+	if result.RelatedEntities == nil {
+		result.RelatedEntities = []RelatedEntity{}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// Continuation returns the continuation marker of a page, numbered from 0, of an entity's RECORDS.
+func Continuation(entityID int64, page int) string {
+	return fmt.Sprintf("ENTITY:%d:PAGE:%d", entityID, page)
+}
+
+// ParseContinuation returns the entity and page, numbered from 0, of a continuation marker from RECORDS_PAGE.
+func ParseContinuation(continuation string) (int64, int, error) {
+	var entityID int64
+	var page int
+	_, err := fmt.Sscanf(continuation, "ENTITY:%d:PAGE:%d", &entityID, &page)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid continuation %q: %w", continuation, err)
+	}
+	return entityID, page, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Summarize records by data source, in order of first appearance.
+// A data source was first seen at the earliest FIRST_SEEN_DT of its records, or LAST_SEEN_DT where that is empty,
+// and last seen at the latest LAST_SEEN_DT.
+func summarizeRecords(records []Record) []RecordSummary {
+	result := []RecordSummary{}
+	index := map[string]int{}
+	for _, record := range records {
+		firstSeen := record.FirstSeenDt
+		if len(firstSeen) == 0 {
+			firstSeen = record.LastSeenDt
+		}
+		i, ok := index[record.DataSource]
+		if !ok {
+			i = len(result)
+			index[record.DataSource] = i
+			result = append(result, RecordSummary{
+				DataSource:  record.DataSource,
+				FirstSeenDt: firstSeen,
+			})
+		}
+		result[i].RecordCount++
+		if timestampBefore(firstSeen, result[i].FirstSeenDt) {
+			result[i].FirstSeenDt = firstSeen
+		}
+		if timestampBefore(result[i].LastSeenDt, record.LastSeenDt) {
+			result[i].LastSeenDt = record.LastSeenDt
+		}
+	}
+	return result
+}
@@ -0,0 +1,167 @@
+package resultbuilder
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// HowMemberRecord describes an entry in MEMBER_RECORDS.
+type HowMemberRecord struct {
+	InternalID int64         `json:"INTERNAL_ID"`
+	Records    []FocusRecord `json:"RECORDS"`
+}
+
+// HowVirtualEntity describes a virtual entity of a resolution step or of the FINAL_STATE.
+type HowVirtualEntity struct {
+	VirtualEntityID string            `json:"VIRTUAL_ENTITY_ID"`
+	MemberRecords   []HowMemberRecord `json:"MEMBER_RECORDS"`
+}
+
+// HowMatchInfo describes the MATCH_INFO of a resolution step.
+type HowMatchInfo struct {
+	MatchKey   string `json:"MATCH_KEY"`
+	ErruleCode string `json:"ERRULE_CODE"`
+}
+
+// HowStep describes an entry in RESOLUTION_STEPS.
+type HowStep struct {
+	Step                   int              `json:"STEP"`
+	VirtualEntity1         HowVirtualEntity `json:"VIRTUAL_ENTITY_1"`
+	VirtualEntity2         HowVirtualEntity `json:"VIRTUAL_ENTITY_2"`
+	InboundVirtualEntityID string           `json:"INBOUND_VIRTUAL_ENTITY_ID"`
+	ResultVirtualEntityID  string           `json:"RESULT_VIRTUAL_ENTITY_ID"`
+	MatchInfo              HowMatchInfo     `json:"MATCH_INFO"`
+}
+
+// HowDoc builds the documents returned by HowEntityByEntityID().
+type HowDoc struct {
+	steps            []HowStep
+	current          *HowVirtualEntity
+	finalState       []HowVirtualEntity
+	needReevaluation int
+}
+
+type howFinalStateJson struct {
+	NeedReevaluation int                `json:"NEED_REEVALUATION"`
+	VirtualEntities  []HowVirtualEntity `json:"VIRTUAL_ENTITIES"`
+}
+
+type howResultsJson struct {
+	ResolutionSteps []HowStep         `json:"RESOLUTION_STEPS"`
+	FinalState      howFinalStateJson `json:"FINAL_STATE"`
+}
+
+type howDocJson struct {
+	HowResults howResultsJson `json:"HOW_RESULTS"`
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// NewHowDoc returns an empty how document builder.
+func NewHowDoc() *HowDoc {
+	return &HowDoc{}
+}
+
+// NewVirtualEntity returns a virtual entity with no member records.
+func NewVirtualEntity(virtualEntityID string) *HowVirtualEntity {
+	return &HowVirtualEntity{
+		VirtualEntityID: virtualEntityID,
+		MemberRecords:   []HowMemberRecord{},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+// Member adds a record to MEMBER_RECORDS.
+func (virtualEntity *HowVirtualEntity) Member(internalID int64, dataSourceCode string, recordID string) *HowVirtualEntity {
+	virtualEntity.MemberRecords = append(virtualEntity.MemberRecords, HowMemberRecord{
+		InternalID: internalID,
+		Records:    []FocusRecord{{DataSource: dataSourceCode, RecordID: recordID}},
+	})
+	return virtualEntity
+}
+
+// Start sets the virtual entity that the first call to Resolve() resolves into.
+func (doc *HowDoc) Start(virtualEntity *HowVirtualEntity) *HowDoc {
+	copied := *virtualEntity
+	doc.current = &copied
+	return doc
+}
+
+/*
+Resolve adds a resolution step in which an inbound virtual entity resolves into the current one.
+VIRTUAL_ENTITY_1 is the current virtual entity, set by Start() or by the previous step.
+STEP is numbered automatically, and the result, having the members of both virtual entities,
+becomes the current virtual entity.
+If there is no current virtual entity, the inbound virtual entity becomes the current one and no step is added.
+*/
+func (doc *HowDoc) Resolve(inbound *HowVirtualEntity, resultVirtualEntityID string, matchKey string, erruleCode string) *HowDoc {
+	if doc.current == nil {
+		return doc.Start(inbound)
+	}
+	doc.steps = append(doc.steps, HowStep{
+		Step:                   len(doc.steps) + 1,
+		VirtualEntity1:         *doc.current,
+		VirtualEntity2:         *inbound,
+		InboundVirtualEntityID: inbound.VirtualEntityID,
+		ResultVirtualEntityID:  resultVirtualEntityID,
+		MatchInfo: HowMatchInfo{
+			MatchKey:   matchKey,
+			ErruleCode: erruleCode,
+		},
+	})
+	doc.current = &HowVirtualEntity{
+		VirtualEntityID: resultVirtualEntityID,
+		MemberRecords:   append(append([]HowMemberRecord{}, doc.current.MemberRecords...), inbound.MemberRecords...),
+	}
+	return doc
+}
+
+// FinalState sets the VIRTUAL_ENTITIES of the FINAL_STATE.
+// If it is not called, the FINAL_STATE is the current virtual entity.
+func (doc *HowDoc) FinalState(virtualEntities ...*HowVirtualEntity) *HowDoc {
+	doc.finalState = []HowVirtualEntity{}
+	for _, virtualEntity := range virtualEntities {
+		doc.finalState = append(doc.finalState, *virtualEntity)
+	}
+	return doc
+}
+
+// NeedReevaluation sets the NEED_REEVALUATION of the FINAL_STATE.
+func (doc *HowDoc) NeedReevaluation(needReevaluation bool) *HowDoc {
+	doc.needReevaluation = 0
+	if needReevaluation {
+		doc.needReevaluation = 1
+	}
+	return doc
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// JSON renders the document returned by HowEntityByEntityID().
+func (doc *HowDoc) JSON() string {
+	result := howDocJson{
+		HowResults: howResultsJson{
+			ResolutionSteps: doc.steps,
+			FinalState: howFinalStateJson{
+				NeedReevaluation: doc.needReevaluation,
+				VirtualEntities:  doc.finalState,
+			},
+		},
+	}
+	if result.HowResults.ResolutionSteps == nil {
+		result.HowResults.ResolutionSteps = []HowStep{}
+	}
+	if result.HowResults.FinalState.VirtualEntities == nil {
+		result.HowResults.FinalState.VirtualEntities = []HowVirtualEntity{}
+		if doc.current != nil {
+			result.HowResults.FinalState.VirtualEntities = append(result.HowResults.FinalState.VirtualEntities, *doc.current)
+		}
+	}
+	return render(result)
+}
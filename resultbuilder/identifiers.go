@@ -0,0 +1,53 @@
+package resultbuilder
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the SHA-1 digest of the parts, separated so that ("ab", "c") and ("a", "bc") differ.
+func digest(parts ...string) [sha1.Size]byte {
+	hash := sha1.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	var result [sha1.Size]byte
+	copy(result[:], hash.Sum(nil))
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// RecordID returns the RECORD_ID of a record submitted without one, using the RecordIDFunc option.
+func RecordID(dataSourceCode string, jsonData string) string {
+	return options().RecordIDFunc(dataSourceCode, jsonData)
+}
+
+// FeatureID returns the FEAT_ID of a feature, using the FeatureIDFunc option.
+func FeatureID(featureType string, featureDesc string) int64 {
+	return options().FeatureIDFunc(featureType, featureDesc)
+}
+
+// DefaultRecordID derives a RECORD_ID as the upper-case hex SHA-1 of the data source code and JSON data.
+func DefaultRecordID(dataSourceCode string, jsonData string) string {
+	return fmt.Sprintf("%X", digest(dataSourceCode, jsonData))
+}
+
+// DefaultEntityKey derives an ENTITY_KEY as the upper-case hex SHA-1 of the record's DATA_SOURCE and RECORD_ID.
+func DefaultEntityKey(record Record) string {
+	return fmt.Sprintf("%X", digest(record.DataSource, record.RecordID))
+}
+
+// DefaultFeatureID derives a positive FEAT_ID from the SHA-1 of the feature type and description.
+func DefaultFeatureID(featureType string, featureDesc string) int64 {
+	sum := digest(featureType, featureDesc)
+	return int64(binary.BigEndian.Uint32(sum[:4])>>1) + 1
+}
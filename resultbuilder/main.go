@@ -0,0 +1,44 @@
+package resultbuilder
+
+import (
+	"encoding/json"
+
+	"github.com/senzing/g2-sdk-go-mock/resulthelpers"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default format of the "..._DT" timestamps in generated documents.
+const TimestampFormat = "2006-01-02 15:04:05.000"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Map of MATCH_LEVEL values to MATCH_LEVEL_CODE values.
+var MatchLevelCodes = map[int]string{
+	0:  "",
+	1:  "RESOLVED",
+	2:  "POSSIBLY_SAME",
+	3:  "POSSIBLY_RELATED",
+	4:  "NAME_ONLY",
+	11: "DISCLOSED",
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Render a document, in canonical form if the CanonicalJSON option is set.
+func render(document interface{}) string {
+	resultBytes, _ := json.Marshal(document)
+	result := string(resultBytes)
+	if options().CanonicalJSON {
+		if canonical, err := resulthelpers.Canonicalize(result); err == nil {
+			result = canonical
+		}
+	}
+	return result
+}
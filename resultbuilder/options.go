@@ -0,0 +1,109 @@
+package resultbuilder
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Options control how documents are generated. A zero-value field uses its default.
+type Options struct {
+	CanonicalJSON     bool                                                // Render documents in the canonical form of resulthelpers.Canonicalize(), with sorted keys and stable indentation, for comparison with golden files.
+	RecordIDFunc      func(dataSourceCode string, jsonData string) string // Derives the RECORD_ID of a record submitted without one. nil is DefaultRecordID().
+	EntityKeyFunc     func(record Record) string                          // Derives ENTITY_KEY values that are not set. nil is DefaultEntityKey().
+	FeatureIDFunc     func(featureType string, featureDesc string) int64  // Derives feature IDs that are not set. nil is DefaultFeatureID().
+	TimestampLayout   string                                              // Format of the "..._DT" timestamps. Empty is TimestampFormat.
+	TimestampLocation *time.Location                                      // Time zone of the "..._DT" timestamps. nil keeps the time zone of each time value.
+	Clock             func() time.Time                                    // Current time of generated timestamps. nil is time.Now().
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// The options set with SetOptions(), shared by the package.
+var (
+	currentOptions      Options
+	currentOptionsMutex sync.RWMutex
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return the current options, with defaults in place of zero-value fields.
+func options() Options {
+	result := GetOptions()
+	if result.RecordIDFunc == nil {
+		result.RecordIDFunc = DefaultRecordID
+	}
+	if result.EntityKeyFunc == nil {
+		result.EntityKeyFunc = DefaultEntityKey
+	}
+	if result.FeatureIDFunc == nil {
+		result.FeatureIDFunc = DefaultFeatureID
+	}
+	if len(result.TimestampLayout) == 0 {
+		result.TimestampLayout = TimestampFormat
+	}
+	if result.Clock == nil {
+		result.Clock = time.Now
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// GetOptions returns the options set with SetOptions(). The zero value is returned if none are set.
+func GetOptions() Options {
+	currentOptionsMutex.RLock()
+	defer currentOptionsMutex.RUnlock()
+	return currentOptions
+}
+
+/*
+The SetOptions function replaces the options of the package, which apply to documents generated afterwards.
+To change one option, modify the result of GetOptions().
+The options are shared by the package, so tests that set different options should not run in parallel.
+
+Input
+  - newOptions: The options.
+
+Output
+  - A function that restores the options replaced.
+*/
+func SetOptions(newOptions Options) func() {
+	currentOptionsMutex.Lock()
+	defer currentOptionsMutex.Unlock()
+	previousOptions := currentOptions
+	currentOptions = newOptions
+	return func() {
+		currentOptionsMutex.Lock()
+		defer currentOptionsMutex.Unlock()
+		currentOptions = previousOptions
+	}
+}
+
+/*
+The OverrideOptions function sets the options of the package for the rest of a test,
+restoring the options replaced when the test completes.
+
+Input
+  - test: The test.
+  - newOptions: The options.
+*/
+func OverrideOptions(test testing.TB, newOptions Options) {
+	test.Helper()
+	test.Cleanup(SetOptions(newOptions))
+}
+
+// Now returns the current time of the Clock option.
+func Now() time.Time {
+	return options().Clock()
+}
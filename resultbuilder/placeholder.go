@@ -0,0 +1,109 @@
+package resultbuilder
+
+import (
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type placeholderEntityJson struct {
+	EntityID int64 `json:"ENTITY_ID"`
+}
+
+type placeholderInterestingJson struct {
+	Entities []placeholderEntityJson `json:"ENTITIES"`
+}
+
+type placeholderPathJson struct {
+	EntityPaths []struct{}      `json:"ENTITY_PATHS"`
+	Entities    []entityDocJson `json:"ENTITIES"`
+}
+
+type placeholderNetworkJson struct {
+	EntityPaths        []struct{}      `json:"ENTITY_PATHS"`
+	EntityNetworkLinks []struct{}      `json:"ENTITY_NETWORK_LINKS"`
+	Entities           []entityDocJson `json:"ENTITIES"`
+}
+
+type placeholderInterestingEntitiesJson struct {
+	InterestingEntities placeholderInterestingJson `json:"INTERESTING_ENTITIES"`
+}
+
+type placeholderRecordJson struct {
+	DataSource string   `json:"DATA_SOURCE"`
+	RecordID   string   `json:"RECORD_ID"`
+	JsonData   struct{} `json:"JSON_DATA"`
+}
+
+type placeholderWithInfoJson struct {
+	DataSource          string                     `json:"DATA_SOURCE"`
+	RecordID            string                     `json:"RECORD_ID"`
+	AffectedEntities    []placeholderEntityJson    `json:"AFFECTED_ENTITIES"`
+	InterestingEntities placeholderInterestingJson `json:"INTERESTING_ENTITIES"`
+}
+
+type placeholderCheckRecordJson struct {
+	CheckRecordResponse []struct{} `json:"CHECK_RECORD_RESPONSE"`
+}
+
+type placeholderStatsJson struct {
+	Workload struct{} `json:"workload"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Placeholder function returns a minimal document with the keys of the response of a G2engine method,
+empty arrays and zero values, for tests that need a parseable result but do not inspect it.
+
+Input
+  - methodName: The name of a G2engine method returning a JSON document, e.g. "GetEntityByEntityID_V2".
+
+Output
+  - The document.
+  - False if the method has no placeholder, e.g. GetRedoRecord(), whose empty result means there is no redo record.
+*/
+func Placeholder(methodName string) (string, bool) {
+	methodName = strings.TrimSuffix(methodName, "_V2")
+	switch {
+	case strings.HasSuffix(methodName, "WithInfo"), strings.HasPrefix(methodName, "ProcessWithResponse"):
+		return render(placeholderWithInfoJson{
+			AffectedEntities:    []placeholderEntityJson{},
+			InterestingEntities: placeholderInterestingJson{Entities: []placeholderEntityJson{}},
+		}), true
+	case strings.HasPrefix(methodName, "GetEntityBy"), strings.HasPrefix(methodName, "GetVirtualEntityBy"):
+		return NewEntityDoc().JSON(), true
+	case strings.HasPrefix(methodName, "FindInterestingEntitiesBy"):
+		return render(placeholderInterestingEntitiesJson{
+			InterestingEntities: placeholderInterestingJson{Entities: []placeholderEntityJson{}},
+		}), true
+	case strings.HasPrefix(methodName, "FindNetworkBy"):
+		return render(placeholderNetworkJson{
+			EntityPaths:        []struct{}{},
+			EntityNetworkLinks: []struct{}{},
+			Entities:           []entityDocJson{},
+		}), true
+	case strings.HasPrefix(methodName, "FindPath"):
+		return render(placeholderPathJson{
+			EntityPaths: []struct{}{},
+			Entities:    []entityDocJson{},
+		}), true
+	case strings.HasPrefix(methodName, "HowEntityBy"):
+		return NewHowDoc().JSON(), true
+	case strings.HasPrefix(methodName, "SearchByAttributes"):
+		return NewSearchDoc().JSON(), true
+	case strings.HasPrefix(methodName, "Why"):
+		return NewWhyDoc().JSON(), true
+	case methodName == "GetRecord":
+		return render(placeholderRecordJson{}), true
+	case methodName == "CheckRecord":
+		return render(placeholderCheckRecordJson{CheckRecordResponse: []struct{}{}}), true
+	case methodName == "Stats":
+		return render(placeholderStatsJson{}), true
+	}
+	return "", false
+}
@@ -0,0 +1,332 @@
+package resultbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEntityDoc_JSON(test *testing.T) {
+	lastSeen := time.Date(2023, 2, 16, 21, 43, 10, 0, time.UTC)
+	actual := NewEntityDoc().
+		EntityID(1).
+		Name("JOHNSON").
+		LastSeen(lastSeen).
+		AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1001"}).
+		AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1002", MatchKey: "+NAME+DOB", MatchLevel: 1}).
+		AddRecord(Record{DataSource: "WATCHLIST", RecordID: "1003", MatchKey: "+NAME+ADDRESS", MatchLevel: 1}).
+		AddRelated(RelatedEntity{EntityID: 2, EntityName: "OCEANGUY", MatchLevel: 3, MatchKey: "+ADDRESS"}).
+		JSON()
+	document := entityDocJson{}
+	err := json.Unmarshal([]byte(actual), &document)
+	assert.NoError(test, err)
+	assert.Equal(test, int64(1), document.ResolvedEntity.EntityID)
+	assert.Equal(test, "JOHNSON", document.ResolvedEntity.EntityName)
+	assert.Len(test, document.ResolvedEntity.Records, 3)
+	assert.Equal(test, int64(3), document.ResolvedEntity.Records[2].InternalID)
+	assert.Equal(test, "RESOLVED", document.ResolvedEntity.Records[1].MatchLevelCode)
+	assert.Equal(test, "2023-02-16 21:43:10.000", document.ResolvedEntity.Records[0].LastSeenDt)
+	assert.Equal(test, []RecordSummary{
+		{DataSource: "CUSTOMERS", RecordCount: 2, FirstSeenDt: "2023-02-16 21:43:10.000", LastSeenDt: "2023-02-16 21:43:10.000"},
+		{DataSource: "WATCHLIST", RecordCount: 1, FirstSeenDt: "2023-02-16 21:43:10.000", LastSeenDt: "2023-02-16 21:43:10.000"},
+	}, document.ResolvedEntity.RecordSummary)
+	assert.Len(test, document.RelatedEntities, 1)
+	assert.Equal(test, "POSSIBLY_RELATED", document.RelatedEntities[0].MatchLevelCode)
+}
+
+func TestEntityDoc_JSON_empty(test *testing.T) {
+	actual := NewEntityDoc().JSON()
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":0,"ENTITY_NAME":"","FEATURES":{},"RECORD_SUMMARY":[],"LAST_SEEN_DT":"","RECORDS":[]},"RELATED_ENTITIES":[]}`, actual)
+}
+
+func TestEntityDoc_JSON_canonical(test *testing.T) {
+	OverrideOptions(test, Options{CanonicalJSON: true})
+	actual := NewEntityDoc().EntityID(1).JSON()
+	assert.Equal(test, "{\n  \"RELATED_ENTITIES\": [],\n  \"RESOLVED_ENTITY\": {\n    \"ENTITY_ID\": 1,\n    \"ENTITY_NAME\": \"\",\n    \"FEATURES\": {},\n    \"LAST_SEEN_DT\": \"\",\n    \"RECORDS\": [],\n    \"RECORD_SUMMARY\": []\n  }\n}", actual)
+}
+
+func TestEntityDoc_PageJSON(test *testing.T) {
+	entityDoc := NewEntityDoc().EntityID(7).PageSize(2)
+	for _, recordID := range []string{"1001", "1002", "1003", "1004", "1005"} {
+		entityDoc.AddRecord(Record{DataSource: "CUSTOMERS", RecordID: recordID})
+	}
+	assert.Equal(test, 3, entityDoc.PageCount())
+	assert.Equal(test, entityDoc.PageJSON(0), entityDoc.JSON())
+	recordIDs := []string{}
+	continuation := ""
+	for page := 0; page < entityDoc.PageCount(); page++ {
+		document := entityPageJson{}
+		err := json.Unmarshal([]byte(entityDoc.PageJSON(page)), &document)
+		assert.NoError(test, err)
+		assert.Equal(test, page, document.RecordsPage.Page)
+		assert.Equal(test, 5, document.RecordsPage.TotalRecordCount)
+		assert.Equal(test, 5, document.ResolvedEntity.RecordSummary[0].RecordCount)
+		for _, record := range document.ResolvedEntity.Records {
+			recordIDs = append(recordIDs, record.RecordID)
+		}
+		continuation = document.RecordsPage.Continuation
+		if page < 2 {
+			entityID, nextPage, err := ParseContinuation(continuation)
+			assert.NoError(test, err)
+			assert.Equal(test, int64(7), entityID)
+			assert.Equal(test, page+1, nextPage)
+		}
+	}
+	assert.Equal(test, []string{"1001", "1002", "1003", "1004", "1005"}, recordIDs)
+	assert.Empty(test, continuation)
+}
+
+func TestEntityDoc_PageJSON_negative(test *testing.T) {
+	entityDoc := NewEntityDoc().EntityID(7).PageSize(2)
+	for _, recordID := range []string{"1001", "1002", "1003"} {
+		entityDoc.AddRecord(Record{DataSource: "CUSTOMERS", RecordID: recordID})
+	}
+	assert.Equal(test, entityDoc.PageJSON(0), entityDoc.PageJSON(-1))
+}
+
+func TestParseContinuation_invalid(test *testing.T) {
+	_, _, err := ParseContinuation("PAGE 2")
+	assert.Error(test, err)
+}
+
+func TestWhyDoc_JSON(test *testing.T) {
+	actual := NewWhyDoc().
+		AddResult(NewWhyResult(1, 2).
+			FocusRecord(1, "CUSTOMERS", "1001").
+			FocusRecord2(2, "CUSTOMERS", "1002").
+			WhyKey("+NAME+DOB", "CNAME_CFF").
+			MatchLevel(1).
+			CandidateKey("NAME_KEY", 10, "JOHNSON").
+			FeatureScore("NAME", "Robert Johnson", "Bob Johnson", 92).
+			FeatureScore("DOB", "12/11/1978", "11/12/1978", 60)).
+		AddEntity(NewEntityDoc().EntityID(1)).
+		AddEntity(NewEntityDoc().EntityID(2)).
+		JSON()
+	document := whyDocJson{}
+	err := json.Unmarshal([]byte(actual), &document)
+	assert.NoError(test, err)
+	assert.Len(test, document.WhyResults, 1)
+	whyResult := document.WhyResults[0]
+	assert.Equal(test, int64(2), whyResult.EntityID2)
+	assert.Equal(test, []FocusRecord{{DataSource: "CUSTOMERS", RecordID: "1002"}}, whyResult.FocusRecords2)
+	assert.Equal(test, "RESOLVED", whyResult.MatchInfo.MatchLevelCode)
+	assert.Equal(test, "CLOSE", whyResult.MatchInfo.FeatureScores["NAME"][0].ScoreBucket)
+	assert.Equal(test, "PLAUSIBLE", whyResult.MatchInfo.FeatureScores["DOB"][0].ScoreBucket)
+	assert.Equal(test, "JOHNSON", whyResult.MatchInfo.CandidateKeys["NAME_KEY"][0].FeatDesc)
+	assert.Len(test, document.Entities, 2)
+}
+
+func TestSearchDoc_JSON(test *testing.T) {
+	actual := NewSearchDoc().
+		AddCandidate(NewSearchCandidate(NewEntityDoc().EntityID(3).Name("Smith")).
+			MatchLevel(3).
+			MatchKey("+PNAME+EMAIL", "SF1").
+			FeatureScore("NAME", "Smith", "Bob J Smith", 83)).
+		AddCandidate(NewSearchCandidate(NewEntityDoc().EntityID(1).Name("JOHNSON")).
+			MatchLevel(1).
+			MatchKey("+NAME+DOB", "SF1_CNAME")).
+		AddCandidate(NewSearchCandidate(NewEntityDoc().EntityID(2).Name("OCEANGUY")).
+			MatchLevel(2).
+			MatchKey("+NAME", "CNAME")).
+		SortByMatchLevel().
+		JSON()
+	document := searchDocJson{}
+	err := json.Unmarshal([]byte(actual), &document)
+	assert.NoError(test, err)
+	assert.Len(test, document.ResolvedEntities, 3)
+	entityIDs := []int64{}
+	matchLevelCodes := []string{}
+	for _, resolvedEntity := range document.ResolvedEntities {
+		entityIDs = append(entityIDs, resolvedEntity.Entity.ResolvedEntity.EntityID)
+		matchLevelCodes = append(matchLevelCodes, resolvedEntity.MatchInfo.MatchLevelCode)
+	}
+	assert.Equal(test, []int64{1, 2, 3}, entityIDs)
+	assert.Equal(test, []string{"RESOLVED", "POSSIBLY_SAME", "POSSIBLY_RELATED"}, matchLevelCodes)
+	assert.Equal(test, "LIKELY", document.ResolvedEntities[2].MatchInfo.FeatureScores["NAME"][0].ScoreBucket)
+}
+
+func TestEntityKeyFunc(test *testing.T) {
+	OverrideOptions(test, Options{
+		EntityKeyFunc: func(record Record) string { return "KEY-" + record.RecordID },
+	})
+	actual := NewEntityDoc().
+		AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1001"}).
+		AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1002", EntityKey: "EXPLICIT"}).
+		ResolvedEntity()
+	assert.Equal(test, "KEY-1001", actual.Records[0].EntityKey)
+	assert.Equal(test, "EXPLICIT", actual.Records[1].EntityKey)
+}
+
+func TestFeatureIDFunc(test *testing.T) {
+	featureIDs := map[string]int64{}
+	OverrideOptions(test, Options{
+		FeatureIDFunc: func(featureType string, featureDesc string) int64 {
+			if _, ok := featureIDs[featureDesc]; !ok {
+				featureIDs[featureDesc] = int64(len(featureIDs) + 1)
+			}
+			return featureIDs[featureDesc]
+		},
+	})
+	whyResult := NewWhyResult(1, 2).
+		CandidateKey("NAME_KEY", 0, "JOHNSON").
+		FeatureScore("NAME", "Robert Johnson", "Bob Johnson", 92)
+	assert.Equal(test, int64(1), whyResult.MatchInfo.CandidateKeys["NAME_KEY"][0].FeatID)
+	assert.Equal(test, int64(2), whyResult.MatchInfo.FeatureScores["NAME"][0].InboundFeatID)
+	assert.Equal(test, int64(3), whyResult.MatchInfo.FeatureScores["NAME"][0].CandidateFeatID)
+}
+
+func TestRecordIDFunc(test *testing.T) {
+	assert.Equal(test, RecordID("CUSTOMERS", `{"NAME_LAST":"Smith"}`), RecordID("CUSTOMERS", `{"NAME_LAST":"Smith"}`))
+	assert.NotEqual(test, RecordID("CUSTOMERS", `{"NAME_LAST":"Smith"}`), RecordID("WATCHLIST", `{"NAME_LAST":"Smith"}`))
+	assert.Len(test, RecordID("CUSTOMERS", `{}`), 40)
+	count := 0
+	OverrideOptions(test, Options{
+		RecordIDFunc: func(dataSourceCode string, jsonData string) string {
+			count++
+			return fmt.Sprintf("REC-%03d", count)
+		},
+	})
+	assert.Equal(test, "REC-001", RecordID("CUSTOMERS", `{}`))
+	assert.Equal(test, "REC-002", RecordID("CUSTOMERS", `{}`))
+}
+
+func TestSetOptions(test *testing.T) {
+	restore := SetOptions(Options{TimestampLayout: time.RFC3339})
+	nestedRestore := SetOptions(Options{TimestampLayout: time.Kitchen})
+	assert.Equal(test, time.Kitchen, GetOptions().TimestampLayout)
+	nestedRestore()
+	assert.Equal(test, time.RFC3339, GetOptions().TimestampLayout)
+	restore()
+	assert.Equal(test, Options{}, GetOptions())
+	assert.Equal(test, "2023-02-16 23:30:00.000", FormatTimestamp(time.Date(2023, 2, 16, 23, 30, 0, 0, time.UTC)))
+}
+
+func TestDefaultFeatureID(test *testing.T) {
+	assert.Equal(test, DefaultFeatureID("NAME", "JOHNSON"), DefaultFeatureID("NAME", "JOHNSON"))
+	assert.Positive(test, DefaultFeatureID("NAME", "JOHNSON"))
+	assert.NotEqual(test, DefaultFeatureID("NAME", "JOHNSON"), DefaultFeatureID("NAME_KEY", "JOHNSON"))
+}
+
+func TestFormatTimestamp(test *testing.T) {
+	timestamp := time.Date(2023, 2, 16, 23, 30, 0, 0, time.UTC)
+	assert.Equal(test, "2023-02-16 23:30:00.000", FormatTimestamp(timestamp))
+	OverrideOptions(test, Options{
+		TimestampLayout:   time.RFC3339,
+		TimestampLocation: time.FixedZone("UTC+2", 2*60*60),
+	})
+	assert.Equal(test, "2023-02-17T01:30:00+02:00", FormatTimestamp(timestamp))
+}
+
+func TestEntityDoc_LastSeenNow(test *testing.T) {
+	now := time.Date(2023, 1, 31, 23, 59, 59, 999000000, time.UTC)
+	OverrideOptions(test, Options{
+		TimestampLayout: "02/01/2006 15:04:05",
+		Clock:           func() time.Time { return now },
+	})
+	first := NewEntityDoc().LastSeenNow().AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1001"})
+	now = now.Add(24 * time.Hour)
+	actual := first.LastSeenNow().AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1002"}).ResolvedEntity()
+	assert.Equal(test, "01/02/2023 23:59:59", actual.LastSeenDt)
+	assert.Equal(test, []RecordSummary{
+		{DataSource: "CUSTOMERS", RecordCount: 2, FirstSeenDt: "31/01/2023 23:59:59", LastSeenDt: "01/02/2023 23:59:59"},
+	}, actual.RecordSummary)
+}
+
+func TestHowDoc_JSON(test *testing.T) {
+	actual := NewHowDoc().
+		Start(NewVirtualEntity("V1").Member(1, "CUSTOMERS", "1001")).
+		Resolve(NewVirtualEntity("V2").Member(2, "CUSTOMERS", "1002"), "V1-S1", "+NAME+DOB", "CNAME_CFF").
+		Resolve(NewVirtualEntity("V3").Member(3, "WATCHLIST", "1003"), "V1-S2", "+NAME+ADDRESS", "CNAME_CADDR").
+		JSON()
+	document := howDocJson{}
+	err := json.Unmarshal([]byte(actual), &document)
+	assert.NoError(test, err)
+	steps := document.HowResults.ResolutionSteps
+	assert.Len(test, steps, 2)
+	assert.Equal(test, 2, steps[1].Step)
+	assert.Equal(test, "V1-S1", steps[1].VirtualEntity1.VirtualEntityID)
+	assert.Len(test, steps[1].VirtualEntity1.MemberRecords, 2)
+	assert.Equal(test, "V3", steps[1].InboundVirtualEntityID)
+	assert.Equal(test, "CNAME_CADDR", steps[1].MatchInfo.ErruleCode)
+	finalState := document.HowResults.FinalState.VirtualEntities
+	assert.Len(test, finalState, 1)
+	assert.Equal(test, "V1-S2", finalState[0].VirtualEntityID)
+	assert.Len(test, finalState[0].MemberRecords, 3)
+}
+
+func TestHowDoc_JSON_finalState(test *testing.T) {
+	actual := NewHowDoc().
+		Resolve(NewVirtualEntity("V1").Member(1, "CUSTOMERS", "1001"), "", "", "").
+		FinalState(NewVirtualEntity("V1").Member(1, "CUSTOMERS", "1001"), NewVirtualEntity("V2").Member(2, "CUSTOMERS", "1002")).
+		NeedReevaluation(true).
+		JSON()
+	assert.Equal(test, `{"HOW_RESULTS":{"RESOLUTION_STEPS":[],"FINAL_STATE":{"NEED_REEVALUATION":1,"VIRTUAL_ENTITIES":[{"VIRTUAL_ENTITY_ID":"V1","MEMBER_RECORDS":[{"INTERNAL_ID":1,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}]}]},{"VIRTUAL_ENTITY_ID":"V2","MEMBER_RECORDS":[{"INTERNAL_ID":2,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}]}]}]}}}`, actual)
+}
+
+func TestPlaceholder(test *testing.T) {
+	for _, methodName := range []string{"AddRecordWithInfo", "CheckRecord", "FindNetworkByEntityID_V2", "FindPathExcludingByRecordID", "GetEntityByRecordID_V2", "GetRecord_V2", "HowEntityByEntityID", "ProcessWithResponseResize", "SearchByAttributes_V2", "Stats", "WhyRecords"} {
+		placeholder, ok := Placeholder(methodName)
+		assert.True(test, ok, methodName)
+		assert.True(test, json.Valid([]byte(placeholder)), methodName)
+	}
+	placeholder, _ := Placeholder("FindPathByEntityID")
+	assert.Equal(test, `{"ENTITY_PATHS":[],"ENTITIES":[]}`, placeholder)
+	placeholder, _ = Placeholder("WhyEntities_V2")
+	assert.Equal(test, NewWhyDoc().JSON(), placeholder)
+	_, ok := Placeholder("GetRedoRecord")
+	assert.False(test, ok)
+}
+
+func TestScoreBucket(test *testing.T) {
+	assert.Equal(test, "SAME", ScoreBucket(100))
+	assert.Equal(test, "CLOSE", ScoreBucket(90))
+	assert.Equal(test, "LIKELY", ScoreBucket(80))
+	assert.Equal(test, "PLAUSIBLE", ScoreBucket(50))
+	assert.Equal(test, "NO_CHANCE", ScoreBucket(49))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleEntityDoc_JSON() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/resultbuilder/resultbuilder_test.go
+	entityDoc := NewEntityDoc().
+		EntityID(1).
+		Name("JOHNSON").
+		AddRecord(Record{DataSource: "CUSTOMERS", RecordID: "1001"})
+	fmt.Println(entityDoc.JSON())
+	// Output: {"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"NAME":[{"FEAT_DESC":"JOHNSON"}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"CUSTOMERS","RECORD_COUNT":1,"FIRST_SEEN_DT":"","LAST_SEEN_DT":""}],"LAST_SEEN_DT":"","RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","ENTITY_TYPE":"GENERIC","INTERNAL_ID":1,"ENTITY_KEY":"B1D15D6D87C32AA67F582FA255EDF9330C87A0B8","ENTITY_DESC":"","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":""}]},"RELATED_ENTITIES":[]}
+}
+
+func ExampleWhyDoc_JSON() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/resultbuilder/resultbuilder_test.go
+	whyDoc := NewWhyDoc().
+		AddResult(NewWhyResult(1, 2).WhyKey("+NAME+DOB", "CNAME_CFF").MatchLevel(1))
+	fmt.Println(whyDoc.JSON())
+	// Output: {"WHY_RESULTS":[{"ENTITY_ID":1,"ENTITY_ID_2":2,"MATCH_INFO":{"WHY_KEY":"+NAME+DOB","WHY_ERRULE_CODE":"CNAME_CFF","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{},"FEATURE_SCORES":{}}}],"ENTITIES":[]}
+}
+
+func ExampleSearchDoc_JSON() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/resultbuilder/resultbuilder_test.go
+	searchDoc := NewSearchDoc().
+		AddCandidate(NewSearchCandidate(NewEntityDoc().EntityID(1)).MatchLevel(3).MatchKey("+PNAME+EMAIL", "SF1"))
+	fmt.Println(searchDoc.JSON())
+	// Output: {"RESOLVED_ENTITIES":[{"MATCH_INFO":{"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PNAME+EMAIL","ERRULE_CODE":"SF1","FEATURE_SCORES":{}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"","FEATURES":{},"RECORD_SUMMARY":[],"LAST_SEEN_DT":"","RECORDS":[]},"RELATED_ENTITIES":[]}}]}
+}
+
+func ExampleHowDoc_JSON() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/resultbuilder/resultbuilder_test.go
+	howDoc := NewHowDoc().
+		Start(NewVirtualEntity("V1").Member(1, "CUSTOMERS", "1001")).
+		Resolve(NewVirtualEntity("V2").Member(2, "CUSTOMERS", "1002"), "V1-S1", "+NAME+DOB", "CNAME_CFF")
+	fmt.Println(howDoc.JSON())
+	// Output: {"HOW_RESULTS":{"RESOLUTION_STEPS":[{"STEP":1,"VIRTUAL_ENTITY_1":{"VIRTUAL_ENTITY_ID":"V1","MEMBER_RECORDS":[{"INTERNAL_ID":1,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}]}]},"VIRTUAL_ENTITY_2":{"VIRTUAL_ENTITY_ID":"V2","MEMBER_RECORDS":[{"INTERNAL_ID":2,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}]}]},"INBOUND_VIRTUAL_ENTITY_ID":"V2","RESULT_VIRTUAL_ENTITY_ID":"V1-S1","MATCH_INFO":{"MATCH_KEY":"+NAME+DOB","ERRULE_CODE":"CNAME_CFF"}}],"FINAL_STATE":{"NEED_REEVALUATION":0,"VIRTUAL_ENTITIES":[{"VIRTUAL_ENTITY_ID":"V1-S1","MEMBER_RECORDS":[{"INTERNAL_ID":1,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}]},{"INTERNAL_ID":2,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}]}]}]}}}
+}
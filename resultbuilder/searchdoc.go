@@ -0,0 +1,123 @@
+package resultbuilder
+
+import (
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SearchMatchInfo describes the MATCH_INFO of a RESOLVED_ENTITIES entry.
+type SearchMatchInfo struct {
+	MatchLevel     int                       `json:"MATCH_LEVEL"`
+	MatchLevelCode string                    `json:"MATCH_LEVEL_CODE"`
+	MatchKey       string                    `json:"MATCH_KEY"`
+	ErruleCode     string                    `json:"ERRULE_CODE"`
+	FeatureScores  map[string][]FeatureScore `json:"FEATURE_SCORES"`
+}
+
+// SearchCandidate builds an entry in RESOLVED_ENTITIES.
+type SearchCandidate struct {
+	matchInfo SearchMatchInfo
+	entity    *EntityDoc
+}
+
+// SearchDoc builds the documents returned by SearchByAttributes().
+type SearchDoc struct {
+	candidates []*SearchCandidate
+}
+
+type searchCandidateJson struct {
+	MatchInfo SearchMatchInfo `json:"MATCH_INFO"`
+	Entity    entityDocJson   `json:"ENTITY"`
+}
+
+type searchDocJson struct {
+	ResolvedEntities []searchCandidateJson `json:"RESOLVED_ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// NewSearchDoc returns an empty search response builder.
+func NewSearchDoc() *SearchDoc {
+	return &SearchDoc{}
+}
+
+// NewSearchCandidate returns a RESOLVED_ENTITIES entry for the entity.
+func NewSearchCandidate(entity *EntityDoc) *SearchCandidate {
+	return &SearchCandidate{
+		entity: entity,
+		matchInfo: SearchMatchInfo{
+			FeatureScores: map[string][]FeatureScore{},
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+// AddCandidate adds an entry to RESOLVED_ENTITIES.
+func (doc *SearchDoc) AddCandidate(candidate *SearchCandidate) *SearchDoc {
+	doc.candidates = append(doc.candidates, candidate)
+	return doc
+}
+
+// SortByMatchLevel orders RESOLVED_ENTITIES from the strongest (lowest) MATCH_LEVEL to the weakest.
+// Candidates with the same MATCH_LEVEL keep the order in which they were added.
+func (doc *SearchDoc) SortByMatchLevel() *SearchDoc {
+	sort.SliceStable(doc.candidates, func(i, j int) bool {
+		return doc.candidates[i].matchInfo.MatchLevel < doc.candidates[j].matchInfo.MatchLevel
+	})
+	return doc
+}
+
+// MatchLevel sets the MATCH_LEVEL and the corresponding MATCH_LEVEL_CODE.
+func (candidate *SearchCandidate) MatchLevel(matchLevel int) *SearchCandidate {
+	candidate.matchInfo.MatchLevel = matchLevel
+	candidate.matchInfo.MatchLevelCode = MatchLevelCodes[matchLevel]
+	return candidate
+}
+
+// MatchKey sets the MATCH_KEY and ERRULE_CODE.
+func (candidate *SearchCandidate) MatchKey(matchKey string, erruleCode string) *SearchCandidate {
+	candidate.matchInfo.MatchKey = matchKey
+	candidate.matchInfo.ErruleCode = erruleCode
+	return candidate
+}
+
+// FeatureScore adds a value to FEATURE_SCORES under the given feature type, e.g. "NAME".
+// INBOUND_FEAT_ID and CANDIDATE_FEAT_ID are derived with the FeatureIDFunc option.
+func (candidate *SearchCandidate) FeatureScore(featureType string, inboundFeat string, candidateFeat string, fullScore int) *SearchCandidate {
+	candidate.matchInfo.FeatureScores[featureType] = append(candidate.matchInfo.FeatureScores[featureType], FeatureScore{
+		InboundFeatID:   options().FeatureIDFunc(featureType, inboundFeat),
+		InboundFeat:     inboundFeat,
+		CandidateFeatID: options().FeatureIDFunc(featureType, candidateFeat),
+		CandidateFeat:   candidateFeat,
+		FullScore:       fullScore,
+		ScoreBucket:     ScoreBucket(fullScore),
+		ScoreBehavior:   featureType,
+	})
+	return candidate
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// JSON renders the document returned by SearchByAttributes().
+func (doc *SearchDoc) JSON() string {
+	result := searchDocJson{
+		ResolvedEntities: []searchCandidateJson{},
+	}
+	for _, candidate := range doc.candidates {
+		result.ResolvedEntities = append(result.ResolvedEntities, searchCandidateJson{
+			MatchInfo: candidate.matchInfo,
+			Entity:    candidate.entity.document(),
+		})
+	}
+	return render(result)
+}
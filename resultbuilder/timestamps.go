@@ -0,0 +1,39 @@
+package resultbuilder
+
+import (
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Report whether timestamp a is earlier than timestamp b.
+// Timestamps are compared as times if both parse with the TimestampLayout option, otherwise as strings.
+func timestampBefore(a string, b string) bool {
+	layout := options().TimestampLayout
+	timeA, errA := time.Parse(layout, a)
+	timeB, errB := time.Parse(layout, b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return timeA.Before(timeB)
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// FormatTimestamp formats a time as a "..._DT" value, using the TimestampLayout and TimestampLocation options.
+func FormatTimestamp(timestamp time.Time) string {
+	timestampOptions := options()
+	if timestampOptions.TimestampLocation != nil {
+		timestamp = timestamp.In(timestampOptions.TimestampLocation)
+	}
+	return timestamp.Format(timestampOptions.TimestampLayout)
+}
+
+// Timestamp returns the current time of the Clock option as a "..._DT" value.
+func Timestamp() string {
+	return FormatTimestamp(Now())
+}
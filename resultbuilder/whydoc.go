@@ -0,0 +1,193 @@
+package resultbuilder
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// FocusRecord identifies a record in FOCUS_RECORDS.
+type FocusRecord struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+// CandidateKey describes a value in CANDIDATE_KEYS.
+type CandidateKey struct {
+	FeatID   int64  `json:"FEAT_ID"`
+	FeatDesc string `json:"FEAT_DESC"`
+}
+
+// FeatureScore describes a value in FEATURE_SCORES.
+type FeatureScore struct {
+	InboundFeatID   int64  `json:"INBOUND_FEAT_ID"`
+	InboundFeat     string `json:"INBOUND_FEAT"`
+	CandidateFeatID int64  `json:"CANDIDATE_FEAT_ID"`
+	CandidateFeat   string `json:"CANDIDATE_FEAT"`
+	FullScore       int    `json:"FULL_SCORE"`
+	ScoreBucket     string `json:"SCORE_BUCKET"`
+	ScoreBehavior   string `json:"SCORE_BEHAVIOR"`
+}
+
+// WhyMatchInfo describes the MATCH_INFO of a WHY_RESULTS entry.
+type WhyMatchInfo struct {
+	WhyKey         string                    `json:"WHY_KEY"`
+	WhyErruleCode  string                    `json:"WHY_ERRULE_CODE"`
+	MatchLevelCode string                    `json:"MATCH_LEVEL_CODE"`
+	CandidateKeys  map[string][]CandidateKey `json:"CANDIDATE_KEYS"`
+	FeatureScores  map[string][]FeatureScore `json:"FEATURE_SCORES"`
+}
+
+// WhyResult builds an entry in WHY_RESULTS.
+// FOCUS_RECORDS and the "..._2" fields are omitted when not set, as in WhyEntities() output.
+type WhyResult struct {
+	InternalID    int64         `json:"INTERNAL_ID,omitempty"`
+	EntityID      int64         `json:"ENTITY_ID"`
+	FocusRecords  []FocusRecord `json:"FOCUS_RECORDS,omitempty"`
+	InternalID2   int64         `json:"INTERNAL_ID_2,omitempty"`
+	EntityID2     int64         `json:"ENTITY_ID_2,omitempty"`
+	FocusRecords2 []FocusRecord `json:"FOCUS_RECORDS_2,omitempty"`
+	MatchInfo     WhyMatchInfo  `json:"MATCH_INFO"`
+}
+
+// WhyDoc builds the documents returned by the WhyEntities(), WhyEntityBy...() and WhyRecords() methods.
+type WhyDoc struct {
+	results  []*WhyResult
+	entities []*EntityDoc
+}
+
+type whyDocJson struct {
+	WhyResults []*WhyResult    `json:"WHY_RESULTS"`
+	Entities   []entityDocJson `json:"ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// NewWhyDoc returns an empty why document builder.
+func NewWhyDoc() *WhyDoc {
+	return &WhyDoc{}
+}
+
+// NewWhyResult returns a WHY_RESULTS entry comparing two entities.
+// For WhyEntityByEntityID() and WhyEntityByRecordID(), entityID2 is 0.
+func NewWhyResult(entityID int64, entityID2 int64) *WhyResult {
+	return &WhyResult{
+		EntityID:  entityID,
+		EntityID2: entityID2,
+		MatchInfo: WhyMatchInfo{
+			CandidateKeys: map[string][]CandidateKey{},
+			FeatureScores: map[string][]FeatureScore{},
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+// AddResult adds an entry to WHY_RESULTS.
+func (doc *WhyDoc) AddResult(result *WhyResult) *WhyDoc {
+	doc.results = append(doc.results, result)
+	return doc
+}
+
+// AddEntity adds an entity to ENTITIES.
+func (doc *WhyDoc) AddEntity(entity *EntityDoc) *WhyDoc {
+	doc.entities = append(doc.entities, entity)
+	return doc
+}
+
+// FocusRecord sets INTERNAL_ID and adds a record to FOCUS_RECORDS.
+func (result *WhyResult) FocusRecord(internalID int64, dataSourceCode string, recordID string) *WhyResult {
+	result.InternalID = internalID
+	result.FocusRecords = append(result.FocusRecords, FocusRecord{DataSource: dataSourceCode, RecordID: recordID})
+	return result
+}
+
+// FocusRecord2 sets INTERNAL_ID_2 and adds a record to FOCUS_RECORDS_2.
+func (result *WhyResult) FocusRecord2(internalID int64, dataSourceCode string, recordID string) *WhyResult {
+	result.InternalID2 = internalID
+	result.FocusRecords2 = append(result.FocusRecords2, FocusRecord{DataSource: dataSourceCode, RecordID: recordID})
+	return result
+}
+
+// WhyKey sets the WHY_KEY and WHY_ERRULE_CODE.
+func (result *WhyResult) WhyKey(whyKey string, erruleCode string) *WhyResult {
+	result.MatchInfo.WhyKey = whyKey
+	result.MatchInfo.WhyErruleCode = erruleCode
+	return result
+}
+
+// MatchLevel sets the MATCH_LEVEL_CODE from a MATCH_LEVEL value.
+func (result *WhyResult) MatchLevel(matchLevel int) *WhyResult {
+	result.MatchInfo.MatchLevelCode = MatchLevelCodes[matchLevel]
+	return result
+}
+
+// CandidateKey adds a value to CANDIDATE_KEYS under the given key type, e.g. "NAME_KEY".
+// A featID of 0 is derived with the FeatureIDFunc option.
+func (result *WhyResult) CandidateKey(keyType string, featID int64, featDesc string) *WhyResult {
+	if featID == 0 {
+		featID = options().FeatureIDFunc(keyType, featDesc)
+	}
+	result.MatchInfo.CandidateKeys[keyType] = append(result.MatchInfo.CandidateKeys[keyType], CandidateKey{
+		FeatID:   featID,
+		FeatDesc: featDesc,
+	})
+	return result
+}
+
+// FeatureScore adds a value to FEATURE_SCORES under the given feature type, e.g. "NAME".
+// INBOUND_FEAT_ID and CANDIDATE_FEAT_ID are derived with the FeatureIDFunc option.
+// SCORE_BUCKET is derived from fullScore and SCORE_BEHAVIOR defaults to the feature type.
+func (result *WhyResult) FeatureScore(featureType string, inboundFeat string, candidateFeat string, fullScore int) *WhyResult {
+	result.MatchInfo.FeatureScores[featureType] = append(result.MatchInfo.FeatureScores[featureType], FeatureScore{
+		InboundFeatID:   options().FeatureIDFunc(featureType, inboundFeat),
+		InboundFeat:     inboundFeat,
+		CandidateFeatID: options().FeatureIDFunc(featureType, candidateFeat),
+		CandidateFeat:   candidateFeat,
+		FullScore:       fullScore,
+		ScoreBucket:     ScoreBucket(fullScore),
+		ScoreBehavior:   featureType,
+	})
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// JSON renders the document returned by the Why...() methods.
+func (doc *WhyDoc) JSON() string {
+	result := whyDocJson{
+		WhyResults: doc.results,
+		Entities:   []entityDocJson{},
+	}
+	if result.WhyResults == nil {
+		result.WhyResults = []*WhyResult{}
+	}
+	for _, entity := range doc.entities {
+		result.Entities = append(result.Entities, entity.document())
+	}
+	return render(result)
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+// ScoreBucket returns the SCORE_BUCKET for a FULL_SCORE.
+func ScoreBucket(fullScore int) string {
+	switch {
+	case fullScore >= 100:
+		return "SAME"
+	case fullScore >= 90:
+		return "CLOSE"
+	case fullScore >= 75:
+		return "LIKELY"
+	case fullScore >= 50:
+		return "PLAUSIBLE"
+	default:
+		return "NO_CHANCE"
+	}
+}
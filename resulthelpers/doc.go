@@ -0,0 +1,5 @@
+/*
+The resulthelpers package is used to convert typed response structures into the
+JSON strings held by the "...Result" fields of the mock objects.
+*/
+package resulthelpers
@@ -0,0 +1,207 @@
+package resulthelpers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// CompressedPrefix marks a result compressed by Compress().
+const CompressedPrefix = "gzip+base64:"
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Return a deep copy of value in which nil slices and maps are replaced with empty ones,
+// so they are rendered as [] and {} rather than null.
+func fillZeroValues(value reflect.Value) reflect.Value {
+	result := reflect.New(value.Type()).Elem()
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			result.Set(reflect.New(value.Type().Elem()))
+			result.Elem().Set(fillZeroValues(value.Elem()))
+		}
+	case reflect.Interface:
+		if !value.IsNil() {
+			result.Set(fillZeroValues(value.Elem()))
+		}
+	case reflect.Struct:
+		result.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				result.Field(i).Set(fillZeroValues(value.Field(i)))
+			}
+		}
+	case reflect.Slice:
+		result.Set(reflect.MakeSlice(value.Type(), value.Len(), value.Len()))
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(fillZeroValues(value.Index(i)))
+		}
+	case reflect.Map:
+		result.Set(reflect.MakeMapWithSize(value.Type(), value.Len()))
+		for _, key := range value.MapKeys() {
+			result.SetMapIndex(key, fillZeroValues(value.MapIndex(key)))
+		}
+	default:
+		result.Set(value)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Marshal function renders a typed response, such as a g2-sdk-json-type-definition
+structure, as the JSON string expected in a "...Result" field.
+Nil slices and maps, at any depth, are rendered as [] and {}.
+The value passed in is not modified.
+
+Input
+  - value: The response structure, or a pointer to it.
+
+Output
+  - A JSON document.
+*/
+func Marshal(value interface{}) (string, error) {
+	if value == nil {
+		return "null", nil
+	}
+	filled := fillZeroValues(reflect.ValueOf(value))
+	resultBytes, err := json.Marshal(filled.Interface())
+	return string(resultBytes), err
+}
+
+/*
+The MustMarshal function is like Marshal, but panics if the value cannot be rendered.
+It is intended for initializing "...Result" fields in struct literals.
+
+Input
+  - value: The response structure, or a pointer to it.
+
+Output
+  - A JSON document.
+*/
+func MustMarshal(value interface{}) string {
+	result, err := Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+/*
+The Canonicalize function rewrites a JSON document in canonical form:
+object keys are sorted and the document is indented with two spaces.
+Numbers are kept as written. Documents that are equal as JSON have the same canonical form,
+so golden-file comparisons do not depend on field or map iteration order.
+
+Input
+  - document: A JSON document.
+
+Output
+  - The canonical form of the document.
+*/
+func Canonicalize(document string) (string, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewBufferString(document))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	resultBytes, err := json.MarshalIndent(value, "", "  ")
+	return string(resultBytes), err
+}
+
+/*
+The Compress function gzip-compresses a result and encodes it as CompressedPrefix followed by base64.
+
+Input
+  - document: A result, usually a JSON document.
+
+Output
+  - The compressed result.
+*/
+func Compress(document string) string {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	_, _ = writer.Write([]byte(document))
+	_ = writer.Close()
+	return CompressedPrefix + base64.StdEncoding.EncodeToString(buffer.Bytes())
+}
+
+/*
+The Decompress function reverses Compress(). Results without CompressedPrefix are returned unchanged.
+
+Input
+  - result: A result, compressed or not.
+
+Output
+  - The uncompressed result.
+*/
+func Decompress(result string) (string, error) {
+	if !strings.HasPrefix(result, CompressedPrefix) {
+		return result, nil
+	}
+	compressed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(result, CompressedPrefix))
+	if err != nil {
+		return "", err
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	document, err := io.ReadAll(reader)
+	return string(document), err
+}
+
+/*
+The SetResults function sets the "...Result" fields of a mock object by name,
+e.g. {"GetRecordResult": `{...}`, "CountRedoRecordsResult": 2}.
+Values are converted to the type of the field, e.g. an int to int64.
+
+Input
+  - mock: A pointer to a mock object, e.g. a *g2engine.G2engine.
+  - results: The values of the fields, by field name.
+
+Output
+  - An error if a name is not a "...Result" field of the mock object,
+    or the value cannot be converted to the type of the field. No field is set then.
+*/
+func SetResults(mock interface{}, results map[string]interface{}) error {
+	value := reflect.ValueOf(mock)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a pointer to a mock object", mock)
+	}
+	value = value.Elem()
+	converted := map[string]reflect.Value{}
+	for name, result := range results {
+		field, ok := value.Type().FieldByName(name)
+		if !ok || !field.IsExported() || !strings.HasSuffix(name, "Result") {
+			return fmt.Errorf("%s is not a ...Result field of %s", name, value.Type())
+		}
+		resultValue := reflect.ValueOf(result)
+		// Numbers are convertible to strings as runes, which is never intended.
+		if !resultValue.IsValid() || !resultValue.Type().ConvertibleTo(field.Type) || (resultValue.Kind() == reflect.String) != (field.Type.Kind() == reflect.String) {
+			return fmt.Errorf("%s of %s cannot be set to %T", name, value.Type(), result)
+		}
+		converted[name] = resultValue.Convert(field.Type)
+	}
+	for name, resultValue := range converted {
+		value.FieldByName(name).Set(resultValue)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package resulthelpers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testFeature struct {
+	FeatDesc string `json:"FEAT_DESC"`
+}
+
+type testEntity struct {
+	EntityID int64                    `json:"ENTITY_ID"`
+	Features map[string][]testFeature `json:"FEATURES"`
+	Records  []string                 `json:"RECORDS"`
+}
+
+type testResponse struct {
+	ResolvedEntity  *testEntity  `json:"RESOLVED_ENTITY"`
+	RelatedEntities []testEntity `json:"RELATED_ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestMarshal(test *testing.T) {
+	response := testResponse{
+		ResolvedEntity: &testEntity{
+			EntityID: 1,
+			Features: map[string][]testFeature{"NAME": nil},
+		},
+		RelatedEntities: []testEntity{{EntityID: 2}},
+	}
+	actual, err := Marshal(response)
+	assert.NoError(test, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"FEATURES":{"NAME":[]},"RECORDS":[]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"FEATURES":{},"RECORDS":[]}]}`, actual)
+	assert.Nil(test, response.ResolvedEntity.Records, "Marshal must not modify its input")
+	assert.Nil(test, response.ResolvedEntity.Features["NAME"], "Marshal must not modify its input")
+}
+
+func TestMarshal_pointer(test *testing.T) {
+	actual, err := Marshal(&testResponse{})
+	assert.NoError(test, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":null,"RELATED_ENTITIES":[]}`, actual)
+}
+
+func TestMustMarshal_panics(test *testing.T) {
+	assert.Panics(test, func() { MustMarshal(make(chan int)) })
+}
+
+func TestCanonicalize(test *testing.T) {
+	first, err := Canonicalize(`{"B":{"D":1.50,"C":[2,1]},"A":"x"}`)
+	assert.NoError(test, err)
+	second, err := Canonicalize(`{"A": "x", "B": {"C": [2, 1], "D": 1.50}}`)
+	assert.NoError(test, err)
+	assert.Equal(test, first, second)
+	assert.Equal(test, "{\n  \"A\": \"x\",\n  \"B\": {\n    \"C\": [\n      2,\n      1\n    ],\n    \"D\": 1.50\n  }\n}", first)
+}
+
+func TestCanonicalize_invalid(test *testing.T) {
+	_, err := Canonicalize(`{"A":`)
+	assert.Error(test, err)
+}
+
+func TestCompress(test *testing.T) {
+	document := `{"RESOLVED_ENTITY":{"ENTITY_ID":1}}`
+	compressed := Compress(document)
+	assert.True(test, strings.HasPrefix(compressed, CompressedPrefix))
+	actual, err := Decompress(compressed)
+	assert.NoError(test, err)
+	assert.Equal(test, document, actual)
+}
+
+func TestDecompress_uncompressed(test *testing.T) {
+	actual, err := Decompress(`{"ENTITY_ID":1}`)
+	assert.NoError(test, err)
+	assert.Equal(test, `{"ENTITY_ID":1}`, actual)
+	_, err = Decompress(CompressedPrefix + "not base64!")
+	assert.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func TestSetResults(test *testing.T) {
+	mock := &struct {
+		GetRecordResult        string
+		CountRedoRecordsResult int64
+		CallLatency            int64
+	}{}
+	err := SetResults(mock, map[string]interface{}{
+		"GetRecordResult":        `{"RECORD_ID":"1001"}`,
+		"CountRedoRecordsResult": 2,
+	})
+	assert.NoError(test, err)
+	assert.Equal(test, `{"RECORD_ID":"1001"}`, mock.GetRecordResult)
+	assert.Equal(test, int64(2), mock.CountRedoRecordsResult)
+	assert.Error(test, SetResults(mock, map[string]interface{}{"CallLatency": 1}))
+	assert.Error(test, SetResults(mock, map[string]interface{}{"GetRecordResult": 1}))
+	assert.Error(test, SetResults(mock, map[string]interface{}{"CountRedoRecordsResult": "2"}))
+	assert.Error(test, SetResults(*mock, map[string]interface{}{}))
+	assert.Equal(test, int64(2), mock.CountRedoRecordsResult)
+}
+
+func ExampleMarshal() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/resulthelpers/resulthelpers_test.go
+	result, err := Marshal(testEntity{EntityID: 1})
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(result)
+	// Output: {"ENTITY_ID":1,"FEATURES":{},"RECORDS":[]}
+}
+
+func ExampleCanonicalize() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/resulthelpers/resulthelpers_test.go
+	result, err := Canonicalize(`{"RECORD_ID":"1001","DATA_SOURCE":"CUSTOMERS"}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(result)
+	// Output:
+	// {
+	//   "DATA_SOURCE": "CUSTOMERS",
+	//   "RECORD_ID": "1001"
+	// }
+}
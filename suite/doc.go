@@ -0,0 +1,5 @@
+/*
+The suite package groups one mock object of each g2-sdk-go-mock package,
+so that tests can configure and observe them together.
+*/
+package suite
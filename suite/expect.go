@@ -0,0 +1,267 @@
+package suite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Expectation describes what must be observed after an action, e.g.
+"after AddRecord with WATCHLIST, a notification 8001 and a redo record must exist within 100ms".
+It is built with Expect() and checked with After().
+*/
+type Expectation struct {
+	suite         *Suite
+	notifications []expectedNotification
+	redoRecords   int64
+	checks        []expectedCheck
+	window        time.Duration
+}
+
+// A notification expected by an Expectation.
+type expectedNotification struct {
+	messageId int
+	details   map[string]string
+}
+
+// A condition on the state of the mocks expected by an Expectation.
+type expectedCheck struct {
+	description string
+	condition   func() bool
+}
+
+// The observer collecting the notifications sent while an Expectation is checked.
+type expectationObserver struct {
+	id       string
+	mutex    sync.Mutex
+	messages []map[string]string
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The time an Expectation waits for, unless set by Within().
+const DefaultExpectationWindow = time.Second
+
+// The interval between two checks of an Expectation.
+const expectationPollInterval = time.Millisecond
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (observer *expectationObserver) GetObserverId(ctx context.Context) string {
+	return observer.id
+}
+
+// Keep the details of a notification. Messages that are not JSON objects of strings are ignored.
+func (observer *expectationObserver) UpdateObserver(ctx context.Context, message string) {
+	details := map[string]string{}
+	if err := json.Unmarshal([]byte(message), &details); err != nil {
+		return
+	}
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	observer.messages = append(observer.messages, details)
+}
+
+// Report whether a notification with the message identifier and details was received.
+func (observer *expectationObserver) received(expected expectedNotification) bool {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	for _, details := range observer.messages {
+		if details["messageId"] != fmt.Sprint(expected.messageId) {
+			continue
+		}
+		matches := true
+		for key, value := range expected.details {
+			if details[key] != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// Return a description of each expectation not met. Empty when all are met.
+func (expectation *Expectation) unmet(observer *expectationObserver) []string {
+	result := []string{}
+	for _, notification := range expectation.notifications {
+		if !observer.received(notification) {
+			result = append(result, "missing "+notification.String())
+		}
+	}
+	if expectation.redoRecords > 0 && expectation.suite.G2engine != nil {
+		if count := expectation.suite.G2engine.RedoRecordCount(); count < expectation.redoRecords {
+			result = append(result, fmt.Sprintf("%d redo records, expected at least %d", count, expectation.redoRecords))
+		}
+	}
+	for _, check := range expectation.checks {
+		if !check.condition() {
+			result = append(result, "failed check: "+check.description)
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Output methods
+// ----------------------------------------------------------------------------
+
+// String describes an expected notification, e.g. "notification 8001 {dataSourceCode=WATCHLIST}".
+func (notification expectedNotification) String() string {
+	if len(notification.details) == 0 {
+		return fmt.Sprintf("notification %d", notification.messageId)
+	}
+	keys := make([]string, 0, len(notification.details))
+	for key := range notification.details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+notification.details[key])
+	}
+	return fmt.Sprintf("notification %d {%s}", notification.messageId, strings.Join(pairs, ", "))
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+/*
+The Notification method expects a notification from any member of the suite.
+
+Input
+  - messageId: The message identifier, e.g. 8001 for G2engine.AddRecord().
+  - details: Details the notification must have, e.g. {"dataSourceCode": "WATCHLIST"}. May be nil.
+*/
+func (expectation *Expectation) Notification(messageId int, details map[string]string) *Expectation {
+	expectation.notifications = append(expectation.notifications, expectedNotification{
+		messageId: messageId,
+		details:   details,
+	})
+	return expectation
+}
+
+/*
+The RedoRecords method expects the redo queue of G2engine, as reported by CountRedoRecords(),
+to hold at least a number of records.
+
+Input
+  - count: The minimum number of redo records.
+*/
+func (expectation *Expectation) RedoRecords(count int64) *Expectation {
+	expectation.redoRecords = count
+	return expectation
+}
+
+/*
+The Check method expects a condition on the state of the mocks, e.g. on G2engine.RecordCount().
+
+Input
+  - description: Reported when the condition does not hold.
+  - condition: Called until it returns true or the window has passed.
+*/
+func (expectation *Expectation) Check(description string, condition func() bool) *Expectation {
+	expectation.checks = append(expectation.checks, expectedCheck{
+		description: description,
+		condition:   condition,
+	})
+	return expectation
+}
+
+/*
+The Within method sets the time the expectations have to be met after the action,
+measured by the clock of the suite.
+Moving the clock with AdvanceClock() during the action uses up the window without waiting.
+
+Input
+  - window: The time allowed. The default is DefaultExpectationWindow.
+*/
+func (expectation *Expectation) Within(window time.Duration) *Expectation {
+	expectation.window = window
+	return expectation
+}
+
+/*
+The After method runs an action and waits until the expectations are met or the window has passed.
+Notifications are collected from every member of the suite while the action runs.
+
+Input
+  - ctx: A context to control lifecycle.
+  - description: Describes the action in errors, e.g. "AddRecord with WATCHLIST".
+  - action: The calls to make.
+
+Output
+  - An error describing the expectations not met, or the error of the action.
+*/
+func (expectation *Expectation) After(ctx context.Context, description string, action func(ctx context.Context) error) error {
+	observer := &expectationObserver{
+		id: "suite.Expectation " + description,
+	}
+	if err := expectation.suite.RegisterObserver(ctx, observer); err != nil {
+		return err
+	}
+	defer func() {
+		_ = expectation.suite.UnregisterObserver(ctx, observer)
+	}()
+	startTime := expectation.suite.Now()
+	if err := action(ctx); err != nil {
+		return fmt.Errorf("after %s: %w", description, err)
+	}
+	deadline := startTime.Add(expectation.window)
+	for {
+		unmet := expectation.unmet(observer)
+		if len(unmet) == 0 {
+			return nil
+		}
+		if !expectation.suite.Now().Before(deadline) {
+			return fmt.Errorf("after %s, within %s: %s", description, expectation.window, strings.Join(unmet, "; "))
+		}
+		select {
+		case <-time.After(expectationPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("after %s: %w", description, ctx.Err())
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Mock inspection methods
+// ----------------------------------------------------------------------------
+
+/*
+The Expect method starts an Expectation on the members of the suite, e.g.
+
+	err := suite.Expect().
+		Notification(8001, map[string]string{"dataSourceCode": "WATCHLIST"}).
+		RedoRecords(1).
+		Within(100*time.Millisecond).
+		After(ctx, "AddRecord with WATCHLIST", func(ctx context.Context) error {
+			return suite.G2engine.AddRecord(ctx, "WATCHLIST", "1001", jsonData, loadID)
+		})
+
+Output
+  - An Expectation with no expectations and the default window.
+*/
+func (suite *Suite) Expect() *Expectation {
+	return &Expectation{
+		suite:  suite,
+		window: DefaultExpectationWindow,
+	}
+}
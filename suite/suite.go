@@ -0,0 +1,175 @@
+package suite
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/g2config"
+	"github.com/senzing/g2-sdk-go-mock/g2configmgr"
+	"github.com/senzing/g2-sdk-go-mock/g2diagnostic"
+	"github.com/senzing/g2-sdk-go-mock/g2engine"
+	"github.com/senzing/g2-sdk-go-mock/g2product"
+	"github.com/senzing/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Suite holds one mock object of each package. Nil members are skipped.
+type Suite struct {
+	G2config     *g2config.G2config
+	G2configmgr  *g2configmgr.G2configmgr
+	G2diagnostic *g2diagnostic.G2diagnostic
+	G2engine     *g2engine.G2engine
+	G2product    *g2product.G2product
+
+	clockMutex  sync.Mutex
+	clockOffset time.Duration
+}
+
+// The observer methods shared by the mock objects.
+type observable interface {
+	RegisterObserver(ctx context.Context, observer observer.Observer) error
+	UnregisterObserver(ctx context.Context, observer observer.Observer) error
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+// New returns a Suite of zero-value mock objects.
+func New() *Suite {
+	return &Suite{
+		G2config:     &g2config.G2config{},
+		G2configmgr:  &g2configmgr.G2configmgr{},
+		G2diagnostic: &g2diagnostic.G2diagnostic{},
+		G2engine:     &g2engine.G2engine{},
+		G2product:    &g2product.G2product{},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Return the members that are set.
+func (suite *Suite) members() []observable {
+	result := []observable{}
+	if suite.G2config != nil {
+		result = append(result, suite.G2config)
+	}
+	if suite.G2configmgr != nil {
+		result = append(result, suite.G2configmgr)
+	}
+	if suite.G2diagnostic != nil {
+		result = append(result, suite.G2diagnostic)
+	}
+	if suite.G2engine != nil {
+		result = append(result, suite.G2engine)
+	}
+	if suite.G2product != nil {
+		result = append(result, suite.G2product)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Mock configuration methods
+// ----------------------------------------------------------------------------
+
+/*
+The SimulateRestart method makes the members of the suite behave as if the engine had been restarted,
+so code that reconnects or re-initializes mid-test can be exercised.
+G2engine becomes cold again and forgets its call counters, and export and configuration handles
+issued before the restart are rejected. Records in the Stateful repository are kept.
+*/
+func (suite *Suite) SimulateRestart() {
+	if suite.G2config != nil {
+		suite.G2config.SimulateRestart()
+	}
+	if suite.G2engine != nil {
+		suite.G2engine.SimulateRestart()
+	}
+}
+
+/*
+The AdvanceClock method moves the clock of the suite forward.
+The clock is installed as the Clock of G2engine, so records of its Stateful repository are first and last seen
+by it, and as the Clock of G2product, so its license validation fails once the license has expired.
+The options of resultbuilder are not changed, so suites advancing their clocks can run in parallel.
+Use Now() for the timestamps of documents built with resultbuilder.
+
+Input
+  - duration: The time added to the clock.
+*/
+func (suite *Suite) AdvanceClock(duration time.Duration) {
+	suite.clockMutex.Lock()
+	suite.clockOffset += duration
+	suite.clockMutex.Unlock()
+	if suite.G2engine != nil {
+		suite.G2engine.Clock = suite.Now
+	}
+	if suite.G2product != nil {
+		suite.G2product.Clock = suite.Now
+	}
+}
+
+/*
+The Now method returns the current time of the clock of the suite:
+the current time, moved forward by AdvanceClock().
+
+Output
+  - The current time of the clock.
+*/
+func (suite *Suite) Now() time.Time {
+	suite.clockMutex.Lock()
+	defer suite.clockMutex.Unlock()
+	return time.Now().Add(suite.clockOffset)
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The RegisterObserver method adds the observer to every member of the suite.
+If a member rejects the observer, it is removed from the members it was added to.
+Each notification carries the "subjectId" of the package that sent it, e.g. "6034" for g2engine.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (suite *Suite) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	registered := []observable{}
+	for _, member := range suite.members() {
+		if err := member.RegisterObserver(ctx, observer); err != nil {
+			for _, registeredMember := range registered {
+				_ = registeredMember.UnregisterObserver(ctx, observer)
+			}
+			return err
+		}
+		registered = append(registered, member)
+	}
+	return nil
+}
+
+/*
+The UnregisterObserver method removes the observer from every member of the suite.
+All members are visited; the first error is returned.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (suite *Suite) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var result error = nil
+	for _, member := range suite.members() {
+		if err := member.UnregisterObserver(ctx, observer); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
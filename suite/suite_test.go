@@ -0,0 +1,227 @@
+package suite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-mock/g2config"
+	"github.com/senzing/g2-sdk-go-mock/g2configmgr"
+	"github.com/senzing/g2-sdk-go-mock/g2diagnostic"
+	"github.com/senzing/g2-sdk-go-mock/g2engine"
+	"github.com/senzing/g2-sdk-go-mock/g2product"
+	"github.com/senzing/g2-sdk-go-mock/resultbuilder"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type testObserver struct {
+	id       string
+	messages chan string
+}
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return observer.id
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	observer.messages <- message
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSuite_RegisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 20),
+	}
+	err := suite.RegisterObserver(ctx, observer)
+	assert.NoError(test, err)
+	subjectIds := map[string]bool{}
+	for len(subjectIds) < 5 {
+		select {
+		case message := <-observer.messages:
+			details := map[string]string{}
+			err = json.Unmarshal([]byte(message), &details)
+			assert.NoError(test, err)
+			subjectIds[details["subjectId"]] = true
+		case <-time.After(time.Second):
+			assert.FailNow(test, "Missing notifications", "%v", subjectIds)
+		}
+	}
+	for _, productId := range []int{g2config.ProductId, g2configmgr.ProductId, g2diagnostic.ProductId, g2engine.ProductId, g2product.ProductId} {
+		assert.True(test, subjectIds[strconv.Itoa(productId)], productId)
+	}
+	err = suite.UnregisterObserver(ctx, observer)
+	assert.NoError(test, err)
+}
+
+func TestSuite_RegisterObserver_partial(test *testing.T) {
+	ctx := context.TODO()
+	suite := &Suite{
+		G2engine: &g2engine.G2engine{},
+	}
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 20),
+	}
+	err := suite.RegisterObserver(ctx, observer)
+	assert.NoError(test, err)
+	err = suite.UnregisterObserver(ctx, observer)
+	assert.NoError(test, err)
+}
+
+func TestSuite_SimulateRestart(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	suite.G2config.CreateResult = 1
+	suite.G2engine.ExportJSONEntityReportResult = 2
+	suite.G2engine.Stateful = true
+	configHandle, err := suite.G2config.Create(ctx)
+	assert.NoError(test, err)
+	exportHandle, err := suite.G2engine.ExportJSONEntityReport(ctx, 0)
+	assert.NoError(test, err)
+	err = suite.G2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "")
+	assert.NoError(test, err)
+	suite.SimulateRestart()
+	_, err = suite.G2config.ListDataSources(ctx, configHandle)
+	assert.Error(test, err)
+	_, err = suite.G2engine.FetchNext(ctx, exportHandle)
+	assert.Error(test, err)
+	assert.Equal(test, 1, suite.G2engine.RecordCount())
+	configHandle, err = suite.G2config.Create(ctx)
+	assert.NoError(test, err)
+	_, err = suite.G2config.ListDataSources(ctx, configHandle)
+	assert.NoError(test, err)
+}
+
+func TestSuite_AdvanceClock(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	suite.G2product.LicenseResult = fmt.Sprintf(`{"expireDate":"%s"}`, time.Now().AddDate(0, 0, 30).Format("2006-01-02"))
+	suite.AdvanceClock(0)
+	_, err := suite.G2product.ValidateLicenseFile(ctx, "g2.lic")
+	assert.NoError(test, err)
+	suite.AdvanceClock(48 * time.Hour)
+	assert.True(test, suite.Now().After(time.Now().Add(47*time.Hour)))
+	assert.True(test, resultbuilder.Now().Before(time.Now().Add(time.Hour)))
+	suite.G2engine.Stateful = true
+	err = suite.G2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, "LOAD-1")
+	assert.NoError(test, err)
+	provenance, ok := suite.G2engine.StoredRecordProvenance("CUSTOMERS", "1001")
+	assert.True(test, ok)
+	assert.True(test, provenance.InsertTime.After(time.Now().Add(47*time.Hour)))
+	suite.AdvanceClock(60 * 24 * time.Hour)
+	_, err = suite.G2product.ValidateLicenseStringBase64(ctx, "")
+	assert.ErrorContains(test, err, "License expired")
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleSuite_RegisterObserver() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/suite/suite_test.go
+	ctx := context.TODO()
+	suite := New()
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 20),
+	}
+	err := suite.RegisterObserver(ctx, observer)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+}
+
+func TestSuite_Expect(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	suite.G2engine.Stateful = true
+	err := suite.Expect().
+		Notification(8001, map[string]string{"dataSourceCode": "WATCHLIST"}).
+		RedoRecords(1).
+		Check("one record stored", func() bool { return suite.G2engine.RecordCount() == 1 }).
+		Within(100*time.Millisecond).
+		After(ctx, "AddRecord with WATCHLIST", func(ctx context.Context) error {
+			suite.G2engine.CountRedoRecordsResult = 1
+			return suite.G2engine.AddRecord(ctx, "WATCHLIST", "1001", `{"NAME_FULL": "Robert Smith"}`, "LOAD-1")
+		})
+	assert.NoError(test, err)
+}
+
+func TestSuite_Expect_redoQueue(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	err := suite.Expect().
+		RedoRecords(2).
+		After(ctx, "pushing redo records", func(ctx context.Context) error {
+			suite.G2engine.PushRedoRecord(`{"REASON":"1"}`, `{"REASON":"2"}`)
+			return nil
+		})
+	assert.NoError(test, err)
+}
+
+func TestSuite_Expect_unmet(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	err := suite.Expect().
+		Notification(8001, map[string]string{"dataSourceCode": "WATCHLIST"}).
+		RedoRecords(1).
+		Within(100*time.Millisecond).
+		After(ctx, "AddRecord with CUSTOMERS", func(ctx context.Context) error {
+			err := suite.G2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, "LOAD-1")
+			suite.AdvanceClock(time.Second)
+			return err
+		})
+	assert.ErrorContains(test, err, "missing notification 8001 {dataSourceCode=WATCHLIST}")
+	assert.ErrorContains(test, err, "0 redo records, expected at least 1")
+}
+
+func TestSuite_UnregisterObserver_error(test *testing.T) {
+	ctx := context.TODO()
+	suite := New()
+	suite.G2config.UnregisterObserverError = errors.New("cannot unregister")
+	observer := &testObserver{
+		id:       "Observer 1",
+		messages: make(chan string, 20),
+	}
+	err := suite.RegisterObserver(ctx, observer)
+	assert.NoError(test, err)
+	err = suite.UnregisterObserver(ctx, observer)
+	assert.ErrorIs(test, err, suite.G2config.UnregisterObserverError)
+	for drained := false; !drained; {
+		select {
+		case <-observer.messages:
+		case <-time.After(50 * time.Millisecond):
+			drained = true
+		}
+	}
+	_, err = suite.G2config.Create(ctx)
+	assert.NoError(test, err)
+	_, err = suite.G2engine.GetActiveConfigID(ctx)
+	assert.NoError(test, err)
+	select {
+	case message := <-observer.messages:
+		assert.Contains(test, message, fmt.Sprintf(`"subjectId":"%d"`, g2config.ProductId))
+	case <-time.After(time.Second):
+		assert.Fail(test, "Missing notification from G2config")
+	}
+	select {
+	case message := <-observer.messages:
+		assert.Fail(test, "Unexpected notification", message)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
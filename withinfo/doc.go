@@ -0,0 +1,5 @@
+/*
+The withinfo package reads the documents returned by the "...WithInfo" methods,
+so tests do not need to parse AFFECTED_ENTITIES and INTERESTING_ENTITIES themselves.
+*/
+package withinfo
@@ -0,0 +1,138 @@
+package withinfo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type entityJson struct {
+	EntityID int64 `json:"ENTITY_ID"`
+}
+
+type withInfoJson struct {
+	DataSource          string       `json:"DATA_SOURCE"`
+	RecordID            string       `json:"RECORD_ID"`
+	AffectedEntities    []entityJson `json:"AFFECTED_ENTITIES"`
+	InterestingEntities struct {
+		Entities []entityJson `json:"ENTITIES"`
+	} `json:"INTERESTING_ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Parse a "...WithInfo" document.
+func parse(document string) (withInfoJson, error) {
+	result := withInfoJson{}
+	err := json.Unmarshal([]byte(document), &result)
+	return result, err
+}
+
+// Return the ENTITY_ID values of a list of entities, in document order.
+func entityIDs(entities []entityJson) []int64 {
+	result := []int64{}
+	for _, entity := range entities {
+		result = append(result, entity.EntityID)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The AffectedEntityIDs function returns the entity IDs listed in AFFECTED_ENTITIES.
+
+Input
+  - document: A document returned by a "...WithInfo" method.
+
+Output
+  - The entity IDs, in document order. Empty if none are listed.
+*/
+func AffectedEntityIDs(document string) ([]int64, error) {
+	parsed, err := parse(document)
+	if err != nil {
+		return nil, err
+	}
+	return entityIDs(parsed.AffectedEntities), nil
+}
+
+/*
+The InterestingEntityIDs function returns the entity IDs listed in INTERESTING_ENTITIES.
+
+Input
+  - document: A document returned by a "...WithInfo" method.
+
+Output
+  - The entity IDs, in document order. Empty if none are listed.
+*/
+func InterestingEntityIDs(document string) ([]int64, error) {
+	parsed, err := parse(document)
+	if err != nil {
+		return nil, err
+	}
+	return entityIDs(parsed.InterestingEntities.Entities), nil
+}
+
+/*
+The Record function returns the DATA_SOURCE and RECORD_ID of the record the document describes.
+
+Input
+  - document: A document returned by a "...WithInfo" method.
+
+Output
+  - The data source code.
+  - The record ID.
+*/
+func Record(document string) (string, string, error) {
+	parsed, err := parse(document)
+	return parsed.DataSource, parsed.RecordID, err
+}
+
+/*
+The AssertAffected function asserts that AFFECTED_ENTITIES lists exactly the given entity IDs, in any order.
+
+Input
+  - test: The test, benchmark or fuzz target.
+  - document: A document returned by a "...WithInfo" method.
+  - entityIDs: The expected entity IDs.
+
+Output
+  - True if the assertion succeeded.
+*/
+func AssertAffected(test testing.TB, document string, entityIDs ...int64) bool {
+	test.Helper()
+	actual, err := AffectedEntityIDs(document)
+	if !assert.NoError(test, err, "parsing WithInfo document") {
+		return false
+	}
+	return assert.ElementsMatch(test, append([]int64{}, entityIDs...), actual, "AFFECTED_ENTITIES")
+}
+
+/*
+The AssertInteresting function asserts that INTERESTING_ENTITIES lists exactly the given entity IDs, in any order.
+
+Input
+  - test: The test, benchmark or fuzz target.
+  - document: A document returned by a "...WithInfo" method.
+  - entityIDs: The expected entity IDs.
+
+Output
+  - True if the assertion succeeded.
+*/
+func AssertInteresting(test testing.TB, document string, entityIDs ...int64) bool {
+	test.Helper()
+	actual, err := InterestingEntityIDs(document)
+	if !assert.NoError(test, err, "parsing WithInfo document") {
+		return false
+	}
+	return assert.ElementsMatch(test, append([]int64{}, entityIDs...), actual, "INTERESTING_ENTITIES")
+}
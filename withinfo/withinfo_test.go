@@ -0,0 +1,68 @@
+package withinfo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDocument = `{"DATA_SOURCE":"TEST","RECORD_ID":"111","AFFECTED_ENTITIES":[{"ENTITY_ID":2},{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[{"ENTITY_ID":3}]}}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestAffectedEntityIDs(test *testing.T) {
+	actual, err := AffectedEntityIDs(testDocument)
+	assert.NoError(test, err)
+	assert.Equal(test, []int64{2, 1}, actual)
+}
+
+func TestAffectedEntityIDs_empty(test *testing.T) {
+	actual, err := AffectedEntityIDs(`{"DATA_SOURCE":"TEST","RECORD_ID":"111","AFFECTED_ENTITIES":[],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`)
+	assert.NoError(test, err)
+	assert.Empty(test, actual)
+}
+
+func TestAffectedEntityIDs_invalid(test *testing.T) {
+	_, err := AffectedEntityIDs(`{"AFFECTED_ENTITIES":`)
+	assert.Error(test, err)
+}
+
+func TestInterestingEntityIDs(test *testing.T) {
+	actual, err := InterestingEntityIDs(testDocument)
+	assert.NoError(test, err)
+	assert.Equal(test, []int64{3}, actual)
+}
+
+func TestRecord(test *testing.T) {
+	dataSourceCode, recordID, err := Record(testDocument)
+	assert.NoError(test, err)
+	assert.Equal(test, "TEST", dataSourceCode)
+	assert.Equal(test, "111", recordID)
+}
+
+func TestAssertAffected(test *testing.T) {
+	assert.True(test, AssertAffected(test, testDocument, 1, 2))
+	assert.False(test, AssertAffected(&testing.T{}, testDocument, 1))
+}
+
+func TestAssertInteresting(test *testing.T) {
+	assert.True(test, AssertInteresting(test, testDocument, 3))
+	assert.False(test, AssertInteresting(&testing.T{}, testDocument))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleAffectedEntityIDs() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-mock/blob/main/withinfo/withinfo_test.go
+	entityIDs, err := AffectedEntityIDs(`{"DATA_SOURCE":"TEST","RECORD_ID":"111","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(entityIDs)
+	// Output: [1]
+}